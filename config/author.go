@@ -0,0 +1,61 @@
+package config
+
+import (
+	"gorm.io/gorm"
+
+	"go-api/models"
+	"go-api/utils"
+)
+
+// SyncBookAuthors kitabın yazar metnini ayırır, kullanıcının yazarlarıyla
+// eşleştirir (yoksa oluşturur) ve kitabın yazar bağlantılarını bunlarla
+// değiştirir. Hiçbir kitaba bağlı olmayan yazarlar silinir.
+func SyncBookAuthors(tx *gorm.DB, book *models.Book) error {
+	authors := []models.Author{}
+	seen := map[string]bool{}
+	for _, name := range utils.SplitAuthorNames(book.Author) {
+		key := utils.AuthorNameKey(name)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		author := models.Author{UserID: book.UserID, Name: name, NameKey: key}
+		if err := tx.Where("user_id = ? AND name_key = ?", book.UserID, key).FirstOrCreate(&author).Error; err != nil {
+			return err
+		}
+		authors = append(authors, author)
+	}
+
+	if err := tx.Model(book).Association("Authors").Replace(authors); err != nil {
+		return err
+	}
+	book.Authors = authors
+	return DeleteOrphanAuthors(tx)
+}
+
+// DeleteOrphanAuthors hiçbir kitaba bağlı olmayan yazarları siler
+func DeleteOrphanAuthors(tx *gorm.DB) error {
+	return tx.Where("id NOT IN (SELECT author_id FROM book_authors)").Delete(&models.Author{}).Error
+}
+
+// MigrateBookAuthors yazar bağlantısı olmayan kitapların (çöp kutusundakiler
+// dahil) yazar metinlerini ayırıp tekilleştirerek Author kayıtlarına bağlar
+func MigrateBookAuthors() error {
+	var books []models.Book
+	err := DB.Unscoped().
+		Where("NOT EXISTS (SELECT 1 FROM book_authors WHERE book_authors.book_id = books.id)").
+		Find(&books).Error
+	if err != nil {
+		return err
+	}
+
+	return DB.Transaction(func(tx *gorm.DB) error {
+		for i := range books {
+			if err := SyncBookAuthors(tx, &books[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
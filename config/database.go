@@ -70,3 +70,25 @@ func FindUserByUsername(username string) (*models.User, error) {
 	}
 	return &user, nil
 }
+
+// MigrateBookReads okuma kaydı olmayan her kitap için mevcut read_date ve
+// rating değerlerinden bir okuma kaydı oluşturur
+func MigrateBookReads() error {
+	var books []models.Book
+	err := DB.Where("NOT EXISTS (SELECT 1 FROM book_reads WHERE book_reads.book_id = books.id)").Find(&books).Error
+	if err != nil {
+		return err
+	}
+
+	for _, book := range books {
+		read := models.BookRead{
+			BookID:   book.ID,
+			ReadDate: book.ReadDate,
+			Rating:   book.Rating,
+		}
+		if err := DB.Create(&read).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
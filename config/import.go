@@ -0,0 +1,21 @@
+package config
+
+import (
+	"time"
+
+	"go-api/models"
+)
+
+// FailStaleImportJobs sunucu kapanırken bekleyen veya çalışan içe aktarma
+// işlerini failed olarak işaretler. Arka plan işleri yalnızca bellekte
+// çalıştığından yeniden başlatma sonrasında devam edemezler.
+func FailStaleImportJobs() (int64, error) {
+	result := DB.Model(&models.ImportJob{}).
+		Where("status IN ?", []string{models.ImportJobPending, models.ImportJobRunning}).
+		Updates(map[string]interface{}{
+			"status":      models.ImportJobFailed,
+			"error":       "Sunucu yeniden başlatıldığı için içe aktarma yarıda kaldı",
+			"finished_at": time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
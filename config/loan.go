@@ -0,0 +1,136 @@
+package config
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-api/models"
+)
+
+// Varsayılan hatırlatma süresi ve kontrol aralığı
+const (
+	defaultLoanReminderDays = 2
+	loanReminderInterval    = time.Hour
+)
+
+// LoanReminderLead iade tarihinden ne kadar önce hatırlatma gönderileceğini
+// döner. LOAN_REMINDER_DAYS ortam değişkeni ile değiştirilebilir.
+func LoanReminderLead() time.Duration {
+	days, err := strconv.Atoi(os.Getenv("LOAN_REMINDER_DAYS"))
+	if err != nil || days < 0 {
+		days = defaultLoanReminderDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// loanReminders ödünç kaydı için gönderilecek bildirimleri hazırlar. Kayıt
+// sahibine her zaman, kitabı ödünç alan uygulama kullanıcısına ise kitap
+// ödünç verildiyse bildirim gönderilir. loan.User ve loan.Contact yüklenmiş olmalıdır.
+func loanReminders(loan models.Loan, stage int) []models.Notification {
+	dueDate := loan.DueDate.Format("02.01.2006")
+	counterpart := loan.Name
+	if loan.Contact != nil {
+		counterpart = loan.Contact.Username
+	}
+
+	var message string
+	switch {
+	case loan.Direction == models.LoanLent && stage == models.LoanReminderOverdue:
+		message = fmt.Sprintf("%s kitabının iadesi gecikti (%s, iade tarihi %s)", loan.Title, counterpart, dueDate)
+	case loan.Direction == models.LoanLent:
+		message = fmt.Sprintf("%s kitabının iade tarihi yaklaşıyor (%s, iade tarihi %s)", loan.Title, counterpart, dueDate)
+	case stage == models.LoanReminderOverdue:
+		message = fmt.Sprintf("Ödünç aldığınız %s kitabının iadesi gecikti (%s, iade tarihi %s)", loan.Title, counterpart, dueDate)
+	default:
+		message = fmt.Sprintf("Ödünç aldığınız %s kitabının iade tarihi yaklaşıyor (%s, iade tarihi %s)", loan.Title, counterpart, dueDate)
+	}
+	notifications := []models.Notification{{
+		UserID:  loan.UserID,
+		Type:    models.NotificationLoanReminder,
+		BookID:  loan.BookID,
+		Message: message,
+	}}
+
+	if loan.Direction == models.LoanLent && loan.Contact != nil && loan.User != nil {
+		message = fmt.Sprintf("%s kullanıcısından ödünç aldığınız %s kitabının iade tarihi yaklaşıyor (%s)", loan.User.Username, loan.Title, dueDate)
+		if stage == models.LoanReminderOverdue {
+			message = fmt.Sprintf("%s kullanıcısından ödünç aldığınız %s kitabının iadesi gecikti (%s)", loan.User.Username, loan.Title, dueDate)
+		}
+		notifications = append(notifications, models.Notification{
+			UserID:  loan.Contact.ID,
+			Type:    models.NotificationLoanReminder,
+			ActorID: &loan.UserID,
+			Message: message,
+		})
+	}
+	return notifications
+}
+
+// SendLoanReminders iade tarihi yaklaşan veya geçen, iade edilmemiş ödünç
+// kayıtları için hatırlatma bildirimlerini yayınlar ve gönderilen hatırlatma
+// sayısını döner. Her kayıt için tarih yaklaşınca ve geçince birer hatırlatma
+// gönderilir.
+func SendLoanReminders(now time.Time, lead time.Duration) (int, error) {
+	var loans []models.Loan
+	err := DB.Preload("User").Preload("Contact").
+		Where("returned_at IS NULL AND due_date IS NOT NULL AND due_date < ? AND reminder_stage < ?", now.Add(lead), models.LoanReminderOverdue).
+		Find(&loans).Error
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, loan := range loans {
+		stage := models.LoanReminderDueSoon
+		if loan.IsOverdue(now) {
+			stage = models.LoanReminderOverdue
+		}
+		if stage <= loan.ReminderStage {
+			continue
+		}
+
+		err := DB.Transaction(func(tx *gorm.DB) error {
+			// Aşama koşullu güncellenir; aynı hatırlatma iki kez gönderilmez
+			result := tx.Model(&models.Loan{}).
+				Where("id = ? AND reminder_stage < ?", loan.ID, stage).
+				Update("reminder_stage", stage)
+			if result.Error != nil || result.RowsAffected == 0 {
+				return result.Error
+			}
+			for _, notification := range loanReminders(loan, stage) {
+				if err := PublishNotification(tx, notification); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// StartLoanReminders ödünç hatırlatmalarını düzenli aralıklarla gönderen arka plan işini başlatır
+func StartLoanReminders() {
+	go func() {
+		ticker := time.NewTicker(loanReminderInterval)
+		defer ticker.Stop()
+
+		for {
+			count, err := SendLoanReminders(time.Now(), LoanReminderLead())
+			if err != nil {
+				log.Println("Ödünç hatırlatmaları gönderilemedi:", err)
+			} else if count > 0 {
+				log.Printf("%d ödünç kaydı için hatırlatma gönderildi", count)
+			}
+			<-ticker.C
+		}
+	}()
+}
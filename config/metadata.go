@@ -0,0 +1,36 @@
+package config
+
+import (
+	"log"
+	"os"
+
+	"go-api/utils"
+)
+
+// defaultMetadataFixtures fixture sağlayıcısının varsayılan dosyası
+const defaultMetadataFixtures = "fixtures/book_metadata.json"
+
+// Metadata ISBN aramalarında kullanılan kitap bilgisi sağlayıcısı
+var Metadata utils.MetadataProvider
+
+// ConnectMetadataProvider BOOK_METADATA_PROVIDER ortam değişkenine göre
+// sağlayıcıyı seçer: "openlibrary" (varsayılan) veya "fixture". Fixture dosyası
+// BOOK_METADATA_FIXTURES, Open Library adresi OPEN_LIBRARY_URL ile değiştirilebilir.
+func ConnectMetadataProvider() {
+	switch provider := os.Getenv("BOOK_METADATA_PROVIDER"); provider {
+	case "", "openlibrary":
+		Metadata = utils.NewOpenLibraryProvider(os.Getenv("OPEN_LIBRARY_URL"))
+	case "fixture":
+		path := os.Getenv("BOOK_METADATA_FIXTURES")
+		if path == "" {
+			path = defaultMetadataFixtures
+		}
+		fixtures, err := utils.NewFixtureProvider(path)
+		if err != nil {
+			log.Fatal("Kitap bilgisi fixture dosyası yüklenemedi:", err)
+		}
+		Metadata = fixtures
+	default:
+		log.Fatalf("Bilinmeyen kitap bilgisi sağlayıcısı: %q", provider)
+	}
+}
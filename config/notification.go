@@ -0,0 +1,183 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-api/models"
+	"go-api/utils"
+)
+
+// Varsayılan e-posta dosyası ve gönderen adresi
+const (
+	defaultMailFile = "mail/outbox.eml"
+	defaultMailFrom = "noreply@localhost"
+)
+
+// Bildirim iletimi ayarları; bir bildirim en fazla maxNotificationAttempts kez
+// denenir, her turda en fazla notificationDispatchBatch bildirim işlenir
+const (
+	notificationDispatchInterval = 10 * time.Second
+	notificationDispatchBatch    = 100
+	maxNotificationAttempts      = 5
+)
+
+// Mailer bildirim e-postalarını gönderen arka uç
+var Mailer utils.Mailer
+
+// ConnectMailer MAIL_DRIVER ortam değişkenine göre e-posta göndericisini seçer:
+// "file" (varsayılan) e-postaları MAIL_FILE dosyasına yazar; "smtp" SMTP_ADDR,
+// SMTP_USERNAME ve SMTP_PASSWORD ile gönderir. Gönderen adresi MAIL_FROM'dur.
+func ConnectMailer() {
+	from := os.Getenv("MAIL_FROM")
+	if from == "" {
+		from = defaultMailFrom
+	}
+
+	switch driver := os.Getenv("MAIL_DRIVER"); driver {
+	case "", "file":
+		path := os.Getenv("MAIL_FILE")
+		if path == "" {
+			path = defaultMailFile
+		}
+		file, err := utils.NewFileMailer(path, from)
+		if err != nil {
+			log.Fatal("E-posta dosyası hazırlanamadı:", err)
+		}
+		Mailer = file
+	case "smtp":
+		smtp, err := utils.NewSMTPMailer(os.Getenv("SMTP_ADDR"), from, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"))
+		if err != nil {
+			log.Fatal("SMTP yapılandırılamadı:", err)
+		}
+		Mailer = smtp
+	default:
+		log.Fatalf("Bilinmeyen e-posta sürücüsü: %q", driver)
+	}
+}
+
+// userNotificationPreference kullanıcının bildirim tercihlerini döner; kaydı
+// yoksa varsayılan tercihler (yalnızca uygulama içi) döner
+func userNotificationPreference(tx *gorm.DB, userID uint) (models.NotificationPreference, error) {
+	preference := models.NotificationPreference{UserID: userID, InApp: true, MutedTypes: []string{}}
+	err := tx.Where("user_id = ?", userID).First(&preference).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return preference, nil
+	}
+	return preference, err
+}
+
+// UserNotificationPreference kullanıcının bildirim tercihlerini döner
+func UserNotificationPreference(userID uint) (models.NotificationPreference, error) {
+	return userNotificationPreference(DB, userID)
+}
+
+// PublishNotification bildirimi kullanıcının tercihlerine göre yayınlar.
+// Bildirim verilen transaction içinde kaydedilir; e-posta ve webhook iletimi
+// transaction tamamlandıktan sonra arka planda yapılır, böylece geri alınan
+// işlemler için bildirim gönderilmez. Kapatılmış türler ve hiçbir kanalı açık
+// olmayan kullanıcılar için kayıt oluşturulmaz.
+func PublishNotification(tx *gorm.DB, notification models.Notification) error {
+	preference, err := userNotificationPreference(tx, notification.UserID)
+	if err != nil {
+		return err
+	}
+	if preference.Mutes(notification.Type) {
+		return nil
+	}
+
+	notification.HiddenInApp = !preference.InApp
+	notification.EmailPending = preference.Email
+	notification.WebhookPending = preference.Webhook && preference.WebhookURL != ""
+	if notification.HiddenInApp && !notification.EmailPending && !notification.WebhookPending {
+		return nil
+	}
+	return tx.Create(&notification).Error
+}
+
+// deliverNotification bildirimi bekleyen kanallara iletir. Başarılı kanallar
+// bekleyenlerden çıkarılır; hata olursa deneme sayısı artar.
+func deliverNotification(notification *models.Notification) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	var errs []error
+	if notification.EmailPending {
+		if notification.User == nil {
+			notification.EmailPending = false
+		} else if err := Mailer.Send(ctx, notification.User.Email, "Yeni bildirim", notification.Message); err != nil {
+			errs = append(errs, err)
+		} else {
+			notification.EmailPending = false
+		}
+	}
+	if notification.WebhookPending {
+		preference, err := userNotificationPreference(DB, notification.UserID)
+		switch {
+		case err != nil:
+			errs = append(errs, err)
+		case !preference.Webhook || preference.WebhookURL == "":
+			// Webhook iletimden önce kapatıldı
+			notification.WebhookPending = false
+		default:
+			if err := utils.PostWebhook(ctx, preference.WebhookURL, notification); err != nil {
+				errs = append(errs, err)
+			} else {
+				notification.WebhookPending = false
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		notification.DeliveryAttempts++
+	}
+	err := DB.Model(notification).
+		Select("email_pending", "webhook_pending", "delivery_attempts").
+		Updates(notification).Error
+	if err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// DispatchNotifications e-posta veya webhook ile iletilmeyi bekleyen
+// bildirimleri iletir ve işlenen bildirim sayısını döner
+func DispatchNotifications() (int, error) {
+	var notifications []models.Notification
+	err := DB.Preload("User").
+		Where("(email_pending OR webhook_pending) AND delivery_attempts < ?", maxNotificationAttempts).
+		Order("id").
+		Limit(notificationDispatchBatch).
+		Find(&notifications).Error
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range notifications {
+		if err := deliverNotification(&notifications[i]); err != nil {
+			log.Printf("Bildirim iletilemedi (%d): %v", notifications[i].ID, err)
+		}
+	}
+	return len(notifications), nil
+}
+
+// StartNotificationDispatch bekleyen bildirimleri düzenli aralıklarla ileten
+// arka plan işini başlatır
+func StartNotificationDispatch() {
+	go func() {
+		ticker := time.NewTicker(notificationDispatchInterval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := DispatchNotifications(); err != nil {
+				log.Println("Bildirimler iletilemedi:", err)
+			}
+			<-ticker.C
+		}
+	}()
+}
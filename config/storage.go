@@ -0,0 +1,77 @@
+package config
+
+import (
+	"log"
+	"net/url"
+	"os"
+	"strings"
+
+	"go-api/utils"
+)
+
+// Yerel depolamanın varsayılan dizini ve sunulduğu adres
+const (
+	defaultStorageDir       = "uploads"
+	defaultStoragePublicURL = "/uploads"
+)
+
+// Storage kitap kapakları gibi yüklenen dosyaların saklandığı arka uç
+var Storage utils.Storage
+
+// LocalStorageDir yerel depolama kullanılıyorsa statik olarak sunulacak dizin,
+// aksi halde boştur. LocalStorageRoute dizinin sunulduğu yol olup
+// STORAGE_PUBLIC_URL'in yol kısmıdır.
+var (
+	LocalStorageDir   string
+	LocalStorageRoute string
+)
+
+// ConnectStorage STORAGE_DRIVER ortam değişkenine göre depolamayı seçer:
+// "local" (varsayılan) dosyaları STORAGE_DIR altında tutar ve
+// STORAGE_PUBLIC_URL adresinin yolunda sunar; "s3" S3_ENDPOINT, S3_REGION,
+// S3_BUCKET, S3_ACCESS_KEY, S3_SECRET_KEY, S3_PATH_STYLE ve S3_PUBLIC_URL ile
+// S3 uyumlu bir depoya bağlanır.
+func ConnectStorage() {
+	switch driver := os.Getenv("STORAGE_DRIVER"); driver {
+	case "", "local":
+		dir := os.Getenv("STORAGE_DIR")
+		if dir == "" {
+			dir = defaultStorageDir
+		}
+		publicURL := os.Getenv("STORAGE_PUBLIC_URL")
+		if publicURL == "" {
+			publicURL = defaultStoragePublicURL
+		}
+		parsed, err := url.Parse(publicURL)
+		if err != nil {
+			log.Fatal("STORAGE_PUBLIC_URL geçersiz:", err)
+		}
+		route := strings.TrimRight(parsed.Path, "/")
+		if route == "" {
+			log.Fatalf("STORAGE_PUBLIC_URL bir alt yol içermelidir: %q", publicURL)
+		}
+		local, err := utils.NewLocalStorage(dir, publicURL)
+		if err != nil {
+			log.Fatal("Yerel depolama dizini oluşturulamadı:", err)
+		}
+		Storage = local
+		LocalStorageDir = dir
+		LocalStorageRoute = route
+	case "s3":
+		s3, err := utils.NewS3Storage(
+			os.Getenv("S3_ENDPOINT"),
+			os.Getenv("S3_REGION"),
+			os.Getenv("S3_BUCKET"),
+			os.Getenv("S3_ACCESS_KEY"),
+			os.Getenv("S3_SECRET_KEY"),
+			os.Getenv("S3_PATH_STYLE") == "true",
+			os.Getenv("S3_PUBLIC_URL"),
+		)
+		if err != nil {
+			log.Fatal("S3 depolaması yapılandırılamadı:", err)
+		}
+		Storage = s3
+	default:
+		log.Fatalf("Bilinmeyen depolama sürücüsü: %q", driver)
+	}
+}
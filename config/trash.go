@@ -0,0 +1,128 @@
+package config
+
+import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-api/models"
+)
+
+// Varsayılan çöp kutusu saklama süresi ve temizleme aralığı
+const (
+	defaultTrashRetentionDays = 30
+	trashPurgeInterval        = time.Hour
+)
+
+// TrashRetention silinen kitapların çöp kutusunda kalacağı süreyi döner.
+// BOOK_TRASH_RETENTION_DAYS ortam değişkeni ile değiştirilebilir.
+func TrashRetention() time.Duration {
+	days, err := strconv.Atoi(os.Getenv("BOOK_TRASH_RETENTION_DAYS"))
+	if err != nil || days <= 0 {
+		days = defaultTrashRetentionDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// DeleteBooksPermanently kitapları ve bağlı kayıtlarını kalıcı olarak siler ve
+// silinen kitapların kapak dosyası anahtarlarını döner. Transaction geri
+// alınabileceğinden dosyalar burada silinmez; çağıran, transaction başarıyla
+// tamamlandıktan sonra DeleteCoverFiles ile siler.
+func DeleteBooksPermanently(tx *gorm.DB, bookIDs []uint) ([]string, error) {
+	if len(bookIDs) == 0 {
+		return nil, nil
+	}
+
+	var covers []models.Book
+	err := tx.Unscoped().Select("cover_key", "thumbnail_key").
+		Where("id IN ? AND cover_key <> ''", bookIDs).Find(&covers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, child := range []interface{}{&models.BookRead{}, &models.Highlight{}, &models.Quote{}, &models.Activity{}, &models.BookLike{}, &models.BookComment{}, &models.Notification{}, &models.BookRevision{}, &models.ReviewAction{}} {
+		if err := tx.Where("book_id IN ?", bookIDs).Delete(child).Error; err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Exec("DELETE FROM book_authors WHERE book_id IN ?", bookIDs).Error; err != nil {
+		return nil, err
+	}
+	// Kulüp okuma listeleri ve ödünç kayıtları başlık ve yazarın kopyasıyla korunur
+	for _, snapshot := range []interface{}{&models.ClubBook{}, &models.Loan{}} {
+		if err := tx.Model(snapshot).Where("book_id IN ?", bookIDs).Update("book_id", nil).Error; err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Unscoped().Where("id IN ?", bookIDs).Delete(&models.Book{}).Error; err != nil {
+		return nil, err
+	}
+	if err := DeleteOrphanAuthors(tx); err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, book := range covers {
+		for _, key := range []string{book.CoverKey, book.ThumbnailKey} {
+			if key != "" {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys, nil
+}
+
+// DeleteCoverFiles kapak dosyalarını depolamadan siler; başarısız silmeler
+// yalnızca loglanır
+func DeleteCoverFiles(keys []string) {
+	for _, key := range keys {
+		if err := Storage.Delete(context.Background(), key); err != nil {
+			log.Printf("Kapak dosyası silinemedi (%s): %v", key, err)
+		}
+	}
+}
+
+// PurgeTrashedBooks saklama süresi dolmuş silinmiş kitapları kalıcı olarak siler
+func PurgeTrashedBooks(retention time.Duration) (int, error) {
+	var bookIDs []uint
+	err := DB.Unscoped().Model(&models.Book{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", time.Now().Add(-retention)).
+		Pluck("id", &bookIDs).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var coverKeys []string
+	err = DB.Transaction(func(tx *gorm.DB) error {
+		keys, err := DeleteBooksPermanently(tx, bookIDs)
+		coverKeys = keys
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	DeleteCoverFiles(coverKeys)
+	return len(bookIDs), nil
+}
+
+// StartTrashPurge çöp kutusunu düzenli aralıklarla temizleyen arka plan işini başlatır
+func StartTrashPurge() {
+	go func() {
+		ticker := time.NewTicker(trashPurgeInterval)
+		defer ticker.Stop()
+
+		for {
+			count, err := PurgeTrashedBooks(TrashRetention())
+			if err != nil {
+				log.Println("Çöp kutusu temizlenemedi:", err)
+			} else if count > 0 {
+				log.Printf("Çöp kutusundan %d kitap kalıcı olarak silindi", count)
+			}
+			<-ticker.C
+		}
+	}()
+}
@@ -0,0 +1,144 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"go-api/config"
+	"go-api/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// Akış sayfa boyutu
+const (
+	defaultFeedLimit = 20
+	maxFeedLimit     = 100
+)
+
+// recordBookActivity kitap için takipçilerin akışında görünecek bir etkinlik
+// yazar. Yalnızca public kitaplar için kayıt tutulur; puanın kaldırılması
+// (rating 0) etkinlik sayılmaz.
+func recordBookActivity(tx *gorm.DB, book models.Book, activityType string, rating int) error {
+	if book.Visibility != models.VisibilityPublic {
+		return nil
+	}
+	if activityType == models.ActivityRated && rating == 0 {
+		return nil
+	}
+
+	activity := models.Activity{
+		UserID: book.UserID,
+		BookID: book.ID,
+		Type:   activityType,
+		Rating: rating,
+	}
+	return tx.Create(&activity).Error
+}
+
+// GetFeed godoc
+// @Summary      Etkinlik akışı
+// @Description  Takip edilen kullanıcıların public kitaplarını bitirme ve puanlama etkinliklerini yeniden eskiye listeler. Sonraki sayfa için yanıttaki next_cursor değeri cursor parametresine verilir.
+// @Tags         follows
+// @Accept       json
+// @Produce      json
+// @Param        cursor  query     int  false  "Önceki sayfanın next_cursor değeri"
+// @Param        limit   query     int  false  "Sayfa boyutu (varsayılan 20, en fazla 100)"
+// @Success      200     {object}  models.FeedResponse
+// @Failure      400     {object}  map[string]interface{}
+// @Failure      401     {object}  map[string]interface{}
+// @Failure      500     {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/feed [get]
+func GetFeed(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	limit := defaultFeedLimit
+	if value := c.Query("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > maxFeedLimit {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"status":  "error",
+				"message": "Geçersiz istek",
+				"error":   "limit 1 ile 100 arasında olmalıdır",
+			})
+			return
+		}
+		limit = parsed
+	}
+
+	// Etkinlikler, kitabı hâlâ public ve silinmemiş olan kabul edilmiş takiplerden gelir
+	query := config.DB.
+		Joins("JOIN follows ON follows.followee_id = activities.user_id").
+		Joins("JOIN books ON books.id = activities.book_id AND books.deleted_at IS NULL").
+		Where("follows.follower_id = ? AND follows.status = ?", userID, models.FollowAccepted).
+		Where("books.visibility = ?", models.VisibilityPublic)
+
+	if value := c.Query("cursor"); value != "" {
+		cursor, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"status":  "error",
+				"message": "Geçersiz istek",
+				"error":   "cursor geçersiz",
+			})
+			return
+		}
+		query = query.Where("activities.id < ?", cursor)
+	}
+
+	// Sonraki sayfanın olup olmadığını anlamak için bir fazla kayıt alınır
+	var activities []models.Activity
+	err := query.Preload("User").Preload("Book").
+		Order("activities.id DESC").
+		Limit(limit + 1).
+		Find(&activities).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Akış alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	response := models.FeedResponse{Items: make([]models.ActivityResponse, 0, len(activities))}
+	if len(activities) > limit {
+		activities = activities[:limit]
+		response.NextCursor = strconv.FormatUint(uint64(activities[limit-1].ID), 10)
+	}
+	for _, activity := range activities {
+		if activity.User == nil || activity.Book == nil {
+			continue
+		}
+		_, thumbnailURL := bookCoverURLs(*activity.Book)
+		response.Items = append(response.Items, models.ActivityResponse{
+			ID:     activity.ID,
+			Type:   activity.Type,
+			Rating: activity.Rating,
+			User:   newPublicUser(*activity.User),
+			Book: models.ActivityBook{
+				ID:           activity.Book.ID,
+				Title:        activity.Book.Title,
+				Author:       activity.Book.Author,
+				ThumbnailURL: thumbnailURL,
+			},
+			CreatedAt: activity.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Akış başarıyla getirildi",
+		"data":    response,
+	})
+}
@@ -0,0 +1,276 @@
+package controllers
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go-api/config"
+	"go-api/models"
+	"go-api/utils"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// loadBookAuthors kitabın yazarlarını book.Authors'a yükler
+func loadBookAuthors(tx *gorm.DB, book *models.Book) error {
+	book.Authors = nil
+	return tx.Model(book).Association("Authors").Find(&book.Authors)
+}
+
+// userAuthorStats kullanıcının yazarlarını çöp kutusunda olmayan kitap sayıları
+// ve ortalama puanlarıyla döner. Puanlanmamış (0) kitaplar ortalamaya katılmaz.
+func userAuthorStats(db *gorm.DB, userID interface{}) *gorm.DB {
+	return db.Table("authors").
+		Select("authors.id, authors.name, COUNT(books.id) AS book_count, "+
+			"AVG(CASE WHEN books.rating > 0 THEN books.rating END) AS average_rating").
+		Joins("LEFT JOIN book_authors ON book_authors.author_id = authors.id").
+		Joins("LEFT JOIN books ON books.id = book_authors.book_id AND books.deleted_at IS NULL").
+		Where("authors.user_id = ?", userID).
+		Group("authors.id")
+}
+
+// roundAuthorRatings ortalama puanları iki basamağa yuvarlar
+func roundAuthorRatings(authors []models.AuthorResponse) {
+	for i := range authors {
+		if rating := authors[i].AverageRating; rating != nil {
+			rounded := math.Round(*rating*100) / 100
+			authors[i].AverageRating = &rounded
+		}
+	}
+}
+
+// GetAuthors godoc
+// @Summary      Yazar listesi
+// @Description  Kullanıcının yazarlarını kitap sayısı ve ortalama puanla listeler
+// @Tags         authors
+// @Accept       json
+// @Produce      json
+// @Param        q     query     string  false  "Yazar adında arama"
+// @Param        sort  query     string  false  "name (varsayılan), book_count veya average_rating"
+// @Success      200   {array}   models.AuthorResponse
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/authors [get]
+func GetAuthors(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	orders := map[string]string{
+		"name":           "authors.name COLLATE NOCASE, authors.id",
+		"book_count":     "book_count DESC, authors.name COLLATE NOCASE",
+		"average_rating": "average_rating IS NULL, average_rating DESC, authors.name COLLATE NOCASE",
+	}
+	order, ok := orders[c.DefaultQuery("sort", "name")]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz sıralama",
+			"error":   "sort name, book_count veya average_rating olmalıdır",
+		})
+		return
+	}
+
+	query := userAuthorStats(config.DB, userID)
+	if q := strings.TrimSpace(c.Query("q")); q != "" {
+		query = query.Where(`authors.name LIKE ? ESCAPE '\'`, containsPattern(q))
+	}
+
+	authors := []models.AuthorResponse{}
+	if err := query.Order(order).Scan(&authors).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Yazarlar alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+	roundAuthorRatings(authors)
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Yazarlar başarıyla getirildi",
+		"data":    authors,
+	})
+}
+
+// renameMergedAuthors kitabın yazar metninde birleştirilen yazarların adlarını
+// hedef yazarın adıyla değiştirir; sıra korunur, tekrar edenler çıkarılır.
+// Yazarlar "&" ile birleştirilir; tek virgül "Soyad, Ad" biçimi sayılır.
+func renameMergedAuthors(value string, mergedKeys map[string]bool, target models.Author) string {
+	var names []string
+	seen := map[string]bool{}
+	for _, name := range utils.SplitAuthorNames(value) {
+		key := utils.AuthorNameKey(name)
+		if mergedKeys[key] {
+			name, key = target.Name, target.NameKey
+		}
+		if !seen[key] {
+			seen[key] = true
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, " & ")
+}
+
+// MergeAuthors godoc
+// @Summary      Yazarları birleştirme
+// @Description  author_ids içindeki yazarları hedef yazarda birleştirir: kitap bağlantıları hedefe taşınır, kitapların yazar metinleri güncellenir ve birleştirilen yazarlar silinir
+// @Tags         authors
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                        true  "Hedef yazar ID"
+// @Param        request  body      models.AuthorMergeRequest  true  "Birleştirilecek yazarlar"
+// @Success      200      {object}  models.AuthorResponse
+// @Failure      400      {object}  map[string]interface{}
+// @Failure      401      {object}  map[string]interface{}
+// @Failure      404      {object}  map[string]interface{}
+// @Failure      500      {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/authors/{id}/merge [post]
+func MergeAuthors(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	authorID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz yazar ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var request models.AuthorMergeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+	for _, id := range request.AuthorIDs {
+		if uint64(id) == authorID {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"status":  "error",
+				"message": "Geçersiz istek",
+				"error":   "yazar kendisiyle birleştirilemez",
+			})
+			return
+		}
+	}
+
+	var target models.Author
+	if err := config.DB.Where("id = ? AND user_id = ?", authorID, userID).First(&target).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Yazar bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var sources []models.Author
+	if err := config.DB.Where("id IN ? AND user_id = ?", request.AuthorIDs, userID).Find(&sources).Error; err != nil || len(sources) != len(uniqueIDs(request.AuthorIDs)) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Birleştirilecek yazarlardan bazıları bulunamadı",
+			"error":   "record not found",
+		})
+		return
+	}
+
+	mergedKeys := map[string]bool{}
+	sourceIDs := make([]uint, len(sources))
+	for i, source := range sources {
+		mergedKeys[source.NameKey] = true
+		sourceIDs[i] = source.ID
+	}
+
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		var books []models.Book
+		err := tx.Unscoped().
+			Where("id IN (SELECT book_id FROM book_authors WHERE author_id IN ?)", sourceIDs).
+			Find(&books).Error
+		if err != nil {
+			return err
+		}
+
+		for _, book := range books {
+			if err := tx.Model(&book).Association("Authors").Append(&target); err != nil {
+				return err
+			}
+			// Yazar metni değiştiği için kitabın sürümü de artırılır
+			err := tx.Unscoped().Model(&models.Book{}).Where("id = ?", book.ID).UpdateColumns(map[string]interface{}{
+				"author":  renameMergedAuthors(book.Author, mergedKeys, target),
+				"version": gorm.Expr("version + 1"),
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Exec("DELETE FROM book_authors WHERE author_id IN ?", sourceIDs).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.Author{}, sourceIDs).Error
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Yazarlar birleştirilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var response []models.AuthorResponse
+	if err := userAuthorStats(config.DB, userID).Where("authors.id = ?", target.ID).Scan(&response).Error; err != nil || len(response) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Yazar bilgileri alınamadı",
+			"error":   "yazar istatistikleri okunamadı",
+		})
+		return
+	}
+	roundAuthorRatings(response)
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Yazarlar başarıyla birleştirildi",
+		"data":    response[0],
+	})
+}
+
+// uniqueIDs tekrar eden ID'leri çıkarır
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	var unique []uint
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,31 @@
+package controllers
+
+import (
+	"reflect"
+	"testing"
+
+	"go-api/models"
+	"go-api/utils"
+)
+
+func TestRenameMergedAuthors(t *testing.T) {
+	target := models.Author{Name: "Neil Gaiman", NameKey: utils.AuthorNameKey("Neil Gaiman")}
+	merged := map[string]bool{utils.AuthorNameKey("N. Gaiman"): true}
+
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{"N. Gaiman & Terry Pratchett", []string{"Neil Gaiman", "Terry Pratchett"}},
+		{"Terry Pratchett; N. Gaiman", []string{"Terry Pratchett", "Neil Gaiman"}},
+		{"N. Gaiman & Neil Gaiman", []string{"Neil Gaiman"}},
+		{"Gaiman, N.", []string{"Neil Gaiman"}},
+		{"Pratchett, Terry", []string{"Terry Pratchett"}},
+	}
+	for _, tt := range tests {
+		result := renameMergedAuthors(tt.value, merged, target)
+		if got := utils.SplitAuthorNames(result); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("renameMergedAuthors(%q) = %q, SplitAuthorNames = %q, want %q", tt.value, result, got, tt.want)
+		}
+	}
+}
@@ -8,18 +8,143 @@ import (
 	"go-api/models"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
+// newBookResponse kitap ve sahibinden detaylı response üretir.
+// book.Reads yeniden eskiye sıralı olarak yüklenmiş olmalıdır.
+func newBookResponse(book models.Book, user models.User) models.BookResponse {
+	response := models.BookResponse{
+		ID:           book.ID,
+		Title:        book.Title,
+		Author:       book.Author,
+		Summary:      book.Summary,
+		ReadDate:     book.ReadDate,
+		Rating:       book.Rating,
+		Notes:        book.Notes,
+		Tags:         book.Tags,
+		ISBN10:       book.ISBN10,
+		ISBN13:       book.ISBN13,
+		PageCount:    book.PageCount,
+		PublishYear:  book.PublishYear,
+		Visibility:   book.Visibility,
+		ShareNotes:   book.ShareNotes,
+		LikeCount:    book.LikeCount,
+		CommentCount: book.CommentCount,
+		Version:      book.Version,
+		CreatedAt:    book.CreatedAt,
+		UpdatedAt:    book.UpdatedAt,
+		Reads:        book.Reads,
+	}
+	response.CoverURL, response.ThumbnailURL = bookCoverURLs(book)
+	response.ShareURL = bookShareURL(book)
+	if book.Series != nil {
+		response.Series = &models.SeriesSummary{
+			ID:       book.Series.ID,
+			Name:     book.Series.Name,
+			Position: book.SeriesPosition,
+		}
+	}
+	response.Authors = make([]models.AuthorSummary, len(book.Authors))
+	for i, author := range book.Authors {
+		response.Authors[i] = models.AuthorSummary{ID: author.ID, Name: author.Name}
+	}
+	if response.Tags == nil {
+		response.Tags = []string{}
+	}
+	if response.Reads == nil {
+		response.Reads = []models.BookRead{}
+	}
+	if len(response.Reads) > 0 {
+		response.LatestRead = &response.Reads[0]
+	}
+	response.User.ID = user.ID
+	response.User.FirstName = user.FirstName
+	response.User.LastName = user.LastName
+	response.User.Username = user.Username
+	return response
+}
+
+// insertBook kitabı ilk okuma kaydıyla birlikte transaction içinde oluşturur:
+// ISBN ve seri denetlenir, görünürlük uygulanır, etkinlik kaydedilir ve yazarlar
+// bağlanır
+func insertBook(tx *gorm.DB, book *models.Book) error {
+	book.Reads = []models.BookRead{{ReadDate: book.ReadDate, Rating: book.Rating}}
+	if err := checkBookISBN(tx, book); err != nil {
+		return err
+	}
+	if err := checkBookSeries(tx, book); err != nil {
+		return err
+	}
+	if err := applyBookVisibility(book); err != nil {
+		return err
+	}
+	if err := tx.Create(book).Error; err != nil {
+		return err
+	}
+	if err := recordBookActivity(tx, *book, models.ActivityFinished, book.Rating); err != nil {
+		return err
+	}
+	return config.SyncBookAuthors(tx, book)
+}
+
+// createBook kitabı ilk okuma kaydıyla birlikte oluşturur; extra verilirse
+// aynı transaction içinde çalışır. ISBN'i veya başlık/yazarı birebir aynı
+// kitap yalnızca allow_duplicate ile ve ISBN farklıysa eklenebilir; benzer
+// kitaplar döner. Hata durumunda yanıtı yazar ve false döner.
+func createBook(c *gin.Context, book *models.Book, failMessage string, extra func(tx *gorm.DB) error) ([]models.DuplicateCandidate, bool) {
+	if err := normalizeBookISBN(book); err != nil {
+		respondBookSaveError(c, err, failMessage)
+		return nil, false
+	}
+	duplicates, err := findDuplicateCandidates(config.DB, *book)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": failMessage,
+			"error":   err.Error(),
+		})
+		return nil, false
+	}
+	if blocksBookCreate(duplicates, c.Query("allow_duplicate") == "true") {
+		c.JSON(http.StatusConflict, gin.H{
+			"status":  "error",
+			"message": "Bu kitap kütüphanede zaten var",
+			"error":   "olası kopya kitaplar bulundu",
+			"data":    duplicates,
+		})
+		return nil, false
+	}
+
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := insertBook(tx, book); err != nil {
+			return err
+		}
+		if extra != nil {
+			return extra(tx)
+		}
+		return nil
+	})
+	if err != nil {
+		respondBookSaveError(c, err, failMessage)
+		return nil, false
+	}
+	notifyGoalMilestone(book.UserID, book.ReadDate.Year())
+	return duplicates, true
+}
+
 // CreateBook godoc
 // @Summary      Kitap ekleme
-// @Description  Yeni bir kitap ve özet ekler
+// @Description  Yeni bir kitap ve özet ekler. Aynı ISBN'e ya da birebir aynı başlık ve yazara sahip kitap varsa 409 ile olası kopyalar döner; başlık ve yazarı çok benzeyen kitaplar varsa kitap eklenir ve yanıttaki duplicates alanında listelenir.
 // @Tags         books
 // @Accept       json
 // @Produce      json
-// @Param        book  body      models.Book  true  "Kitap bilgileri"
+// @Param        book             body      models.Book  true   "Kitap bilgileri"
+// @Param        allow_duplicate  query     bool         false  "true ise aynı başlık ve yazara sahip kitap yine de eklenir (aynı ISBN'e izin verilmez)"
 // @Success      201   {object}  models.BookResponse
 // @Failure      400   {object}  map[string]interface{}
 // @Failure      401   {object}  map[string]interface{}
+// @Failure      409   {array}   models.DuplicateCandidate
 // @Failure      500   {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /api/books [post]
@@ -48,13 +173,8 @@ func CreateBook(c *gin.Context) {
 	// Kullanıcı ID'sini ata
 	book.UserID = userID.(uint)
 
-	// Kitabı veritabanına kaydet
-	if err := config.DB.Create(&book).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"message": "Kitap kaydedilemedi",
-			"error":   err.Error(),
-		})
+	duplicates, ok := createBook(c, &book, "Kitap kaydedilemedi", nil)
+	if !ok {
 		return
 	}
 
@@ -70,27 +190,17 @@ func CreateBook(c *gin.Context) {
 	}
 
 	// Response hazırla
-	response := models.BookResponse{
-		ID:        book.ID,
-		Title:     book.Title,
-		Author:    book.Author,
-		Summary:   book.Summary,
-		ReadDate:  book.ReadDate,
-		Rating:    book.Rating,
-		Notes:     book.Notes,
-		CreatedAt: book.CreatedAt,
-		UpdatedAt: book.UpdatedAt,
-	}
-	response.User.ID = user.ID
-	response.User.FirstName = user.FirstName
-	response.User.LastName = user.LastName
-	response.User.Username = user.Username
+	response := newBookResponse(book, user)
 
-	c.JSON(http.StatusCreated, gin.H{
+	result := gin.H{
 		"status":  "success",
 		"message": "Kitap başarıyla eklendi",
 		"data":    response,
-	})
+	}
+	if len(duplicates) > 0 {
+		result["duplicates"] = duplicates
+	}
+	c.JSON(http.StatusCreated, result)
 }
 
 // GetBooks godoc
@@ -99,7 +209,19 @@ func CreateBook(c *gin.Context) {
 // @Tags         books
 // @Accept       json
 // @Produce      json
+// @Param        q              query     string  false  "Başlık veya yazarda arama"
+// @Param        author         query     string  false  "Yazar"
+// @Param        author_id      query     int     false  "Yazar ID"
+// @Param        series_id      query     int     false  "Seri ID"
+// @Param        tag            query     string  false  "Etiket"
+// @Param        min_rating     query     int     false  "En düşük puan"
+// @Param        max_rating     query     int     false  "En yüksek puan"
+// @Param        read_from      query     string  false  "Okuma tarihi başlangıcı (YYYY-MM-DD)"
+// @Param        read_to        query     string  false  "Okuma tarihi bitişi (YYYY-MM-DD)"
+// @Param        If-None-Match  header    string  false  "Önceki yanıtın ETag değeri"
 // @Success      200  {array}   models.BookListResponse
+// @Success      304  "Liste değişmedi"
+// @Failure      400  {object}  map[string]interface{}
 // @Failure      401  {object}  map[string]interface{}
 // @Failure      500  {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -115,8 +237,18 @@ func GetBooks(c *gin.Context) {
 		return
 	}
 
+	query, err := applyBookFilters(c, config.DB.Where("user_id = ?", userID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz filtre",
+			"error":   err.Error(),
+		})
+		return
+	}
+
 	var books []models.Book
-	if err := config.DB.Where("user_id = ?", userID).Find(&books).Error; err != nil {
+	if err := query.Order("id").Find(&books).Error; err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"status":  "error",
 			"message": "Kitaplar alınamadı",
@@ -125,6 +257,11 @@ func GetBooks(c *gin.Context) {
 		return
 	}
 
+	// Liste değişmediyse 304 dön
+	if checkIfNoneMatch(c, bookListETag(books)) {
+		return
+	}
+
 	// Kullanıcı bilgilerini al
 	var user models.User
 	if err := config.DB.First(&user, userID).Error; err != nil {
@@ -145,6 +282,7 @@ func GetBooks(c *gin.Context) {
 			Author: book.Author,
 			Rating: book.Rating,
 		}
+		bookResponse.CoverURL, bookResponse.ThumbnailURL = bookCoverURLs(book)
 		response = append(response, bookResponse)
 	}
 
@@ -161,8 +299,10 @@ func GetBooks(c *gin.Context) {
 // @Tags         books
 // @Accept       json
 // @Produce      json
-// @Param        id   path      int  true  "Kitap ID"
+// @Param        id             path      int     true   "Kitap ID"
+// @Param        If-None-Match  header    string  false  "Önceki yanıtın ETag değeri"
 // @Success      200  {object}  models.BookResponse
+// @Success      304  "Kitap değişmedi"
 // @Failure      400  {object}  map[string]interface{}
 // @Failure      401  {object}  map[string]interface{}
 // @Failure      404  {object}  map[string]interface{}
@@ -190,7 +330,7 @@ func GetBook(c *gin.Context) {
 	}
 
 	var book models.Book
-	if err := config.DB.Where("id = ? AND user_id = ?", bookID, userID).First(&book).Error; err != nil {
+	if err := config.DB.Preload("Reads", orderReads).Preload("Authors").Preload("Series").Where("id = ? AND user_id = ?", bookID, userID).First(&book).Error; err != nil {
 		c.JSON(http.StatusNotFound, gin.H{
 			"status":  "error",
 			"message": "Kitap bulunamadı",
@@ -199,6 +339,11 @@ func GetBook(c *gin.Context) {
 		return
 	}
 
+	// Kitap değişmediyse 304 dön
+	if checkIfNoneMatch(c, bookETag(book)) {
+		return
+	}
+
 	// Kullanıcı bilgilerini al
 	var user models.User
 	if err := config.DB.First(&user, userID).Error; err != nil {
@@ -211,21 +356,7 @@ func GetBook(c *gin.Context) {
 	}
 
 	// Response hazırla
-	response := models.BookResponse{
-		ID:        book.ID,
-		Title:     book.Title,
-		Author:    book.Author,
-		Summary:   book.Summary,
-		ReadDate:  book.ReadDate,
-		Rating:    book.Rating,
-		Notes:     book.Notes,
-		CreatedAt: book.CreatedAt,
-		UpdatedAt: book.UpdatedAt,
-	}
-	response.User.ID = user.ID
-	response.User.FirstName = user.FirstName
-	response.User.LastName = user.LastName
-	response.User.Username = user.Username
+	response := newBookResponse(book, user)
 
 	c.JSON(http.StatusOK, gin.H{
 		"status":  "success",
@@ -240,12 +371,14 @@ func GetBook(c *gin.Context) {
 // @Tags         books
 // @Accept       json
 // @Produce      json
-// @Param        id    path      int         true  "Kitap ID"
-// @Param        book  body      models.Book  true  "Kitap bilgileri"
+// @Param        id        path      int          true   "Kitap ID"
+// @Param        If-Match  header    string       false  "Kitabın güncel ETag değeri"
+// @Param        book      body      models.Book  true   "Kitap bilgileri"
 // @Success      200   {object}  models.BookResponse
 // @Failure      400   {object}  map[string]interface{}
 // @Failure      401   {object}  map[string]interface{}
 // @Failure      404   {object}  map[string]interface{}
+// @Failure      412   {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /api/books/{id} [put]
 func UpdateBook(c *gin.Context) {
@@ -279,7 +412,13 @@ func UpdateBook(c *gin.Context) {
 		return
 	}
 
-	// Yeni bilgileri bind et
+	if !checkIfMatch(c, book) {
+		return
+	}
+
+	// Yeni bilgileri bind et; oluşturulma zamanı ve sürüm istemciden alınmaz
+	createdAt, version, rating := book.CreatedAt, book.Version, book.Rating
+	before := book
 	if err := c.ShouldBindJSON(&book); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"status":  "error",
@@ -292,63 +431,61 @@ func UpdateBook(c *gin.Context) {
 	// ID ve UserID değiştirilemesin
 	book.ID = uint(bookID)
 	book.UserID = userID.(uint)
+	book.CreatedAt = createdAt
+	book.Version = version
 
-	// Güncelle
-	if err := config.DB.Save(&book).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"message": "Kitap güncellenemedi",
-			"error":   err.Error(),
-		})
-		return
-	}
-
-	// Kullanıcı bilgilerini al
-	var user models.User
-	if err := config.DB.First(&user, userID).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"message": "Kullanıcı bilgileri alınamadı",
-			"error":   err.Error(),
-		})
+	// Güncelle; read_date ve rating en son okuma kaydına yazılır
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := checkBookISBN(tx, &book); err != nil {
+			return err
+		}
+		if err := checkBookSeries(tx, &book); err != nil {
+			return err
+		}
+		if err := applyBookVisibility(&book); err != nil {
+			return err
+		}
+		if err := bumpBookVersion(tx, &book); err != nil {
+			return err
+		}
+		if err := tx.Save(&book).Error; err != nil {
+			return err
+		}
+		if err := config.SyncBookAuthors(tx, &book); err != nil {
+			return err
+		}
+		if book.Rating != rating {
+			if err := recordBookActivity(tx, book, models.ActivityRated, book.Rating); err != nil {
+				return err
+			}
+		}
+		if err := recordBookRevision(tx, before, book, models.RevisionUpdate, 0); err != nil {
+			return err
+		}
+		return updateLatestRead(tx, &book)
+	})
+	if err != nil {
+		respondBookSaveError(c, err, "Kitap güncellenemedi")
 		return
 	}
 
-	// Response hazırla
-	response := models.BookResponse{
-		ID:        book.ID,
-		Title:     book.Title,
-		Author:    book.Author,
-		Summary:   book.Summary,
-		ReadDate:  book.ReadDate,
-		Rating:    book.Rating,
-		Notes:     book.Notes,
-		CreatedAt: book.CreatedAt,
-		UpdatedAt: book.UpdatedAt,
-	}
-	response.User.ID = user.ID
-	response.User.FirstName = user.FirstName
-	response.User.LastName = user.LastName
-	response.User.Username = user.Username
-
-	c.JSON(http.StatusOK, gin.H{
-		"status":  "success",
-		"message": "Kitap başarıyla güncellendi",
-		"data":    response,
-	})
+	respondBookDetail(c, userID, book, "Kitap başarıyla güncellendi")
 }
 
 // DeleteBook godoc
 // @Summary      Kitap silme
-// @Description  Belirtilen kitabı siler
+// @Description  Belirtilen kitabı çöp kutusuna taşır; permanent=true ile kalıcı olarak siler
 // @Tags         books
 // @Accept       json
 // @Produce      json
-// @Param        id   path      int  true  "Kitap ID"
+// @Param        id         path      int     true   "Kitap ID"
+// @Param        permanent  query     bool    false  "Kalıcı olarak sil"
+// @Param        If-Match   header    string  false  "Kitabın güncel ETag değeri"
 // @Success      200  {object}  map[string]interface{}
 // @Failure      400  {object}  map[string]interface{}
 // @Failure      401  {object}  map[string]interface{}
 // @Failure      404  {object}  map[string]interface{}
+// @Failure      412  {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /api/books/{id} [delete]
 func DeleteBook(c *gin.Context) {
@@ -372,7 +509,32 @@ func DeleteBook(c *gin.Context) {
 		return
 	}
 
-	result := config.DB.Where("id = ? AND user_id = ?", bookID, userID).Delete(&models.Book{})
+	if permanent, _ := strconv.ParseBool(c.Query("permanent")); permanent {
+		deleteBookPermanently(c, userID, bookID)
+		return
+	}
+
+	query := config.DB.Where("id = ? AND user_id = ?", bookID, userID)
+
+	// If-Match gönderildiyse yalnızca eşleşen sürüm silinir
+	ifMatch := c.GetHeader("If-Match") != ""
+	if ifMatch {
+		book, err := findUserBook(config.DB, userID, bookID)
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{
+				"status":  "error",
+				"message": "Kitap bulunamadı",
+				"error":   err.Error(),
+			})
+			return
+		}
+		if !checkIfMatch(c, book) {
+			return
+		}
+		query = query.Where("version = ?", book.Version)
+	}
+
+	result := query.Delete(&models.Book{})
 	if result.Error != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"status":  "error",
@@ -382,6 +544,11 @@ func DeleteBook(c *gin.Context) {
 		return
 	}
 
+	if result.RowsAffected == 0 && ifMatch {
+		respondBookSaveError(c, errVersionConflict, "Kitap silinemedi")
+		return
+	}
+
 	if result.RowsAffected == 0 {
 		c.JSON(http.StatusNotFound, gin.H{
 			"status":  "error",
@@ -0,0 +1,292 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+
+	"go-api/config"
+	"go-api/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+// defaultBookBatchMaxSize tek istekte kabul edilen varsayılan işlem sayısı
+const defaultBookBatchMaxSize = 100
+
+// bookBatchMaxSize BOOK_BATCH_MAX_SIZE ortam değişkeninden en fazla işlem sayısını okur
+func bookBatchMaxSize() int {
+	size, err := strconv.Atoi(os.Getenv("BOOK_BATCH_MAX_SIZE"))
+	if err != nil || size <= 0 {
+		return defaultBookBatchMaxSize
+	}
+	return size
+}
+
+// preparedBookOperation doğrulanmış ve uygulanmaya hazır toplu işlem
+type preparedBookOperation struct {
+	models.BookBatchOperation
+	book  models.Book
+	patch models.BookPatch
+}
+
+// prepareBookOperation işlemin gövdesini çözer ve doğrular
+func prepareBookOperation(operation models.BookBatchOperation) (preparedBookOperation, error) {
+	prepared := preparedBookOperation{BookBatchOperation: operation}
+
+	switch operation.Op {
+	case "create":
+		if len(operation.Book) == 0 {
+			return prepared, errors.New("create işlemi için book alanı zorunludur")
+		}
+		if err := json.Unmarshal(operation.Book, &prepared.book); err != nil {
+			return prepared, err
+		}
+		if err := binding.Validator.ValidateStruct(&prepared.book); err != nil {
+			return prepared, err
+		}
+		prepared.book.ID = 0
+		prepared.book.Version = 0
+	case "update":
+		if operation.ID == 0 {
+			return prepared, errors.New("update işlemi için id alanı zorunludur")
+		}
+		if len(operation.Book) == 0 {
+			return prepared, errors.New("update işlemi için book alanı zorunludur")
+		}
+		decoder := json.NewDecoder(bytes.NewReader(operation.Book))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&prepared.patch); err != nil {
+			return prepared, err
+		}
+		if err := binding.Validator.ValidateStruct(&prepared.patch); err != nil {
+			return prepared, err
+		}
+	case "delete":
+		if operation.ID == 0 {
+			return prepared, errors.New("delete işlemi için id alanı zorunludur")
+		}
+	}
+	return prepared, nil
+}
+
+// executeBookOperation işlemi verilen transaction içinde uygular; etkilenen
+// kitabın ID'sini ve create işlemlerinde olası kopyaları döner. Kopya denetimi
+// kitap eklemedeki gibidir; aynı batch'te daha önce eklenen kitaplar da
+// denetlenir.
+func executeBookOperation(tx *gorm.DB, userID uint, operation preparedBookOperation, allowDuplicate bool) (uint, []models.DuplicateCandidate, error) {
+	if operation.Op == "create" {
+		book := operation.book
+		book.UserID = userID
+		if err := normalizeBookISBN(&book); err != nil {
+			return 0, nil, err
+		}
+		duplicates, err := findDuplicateCandidates(tx, book)
+		if err != nil {
+			return 0, nil, err
+		}
+		if blocksBookCreate(duplicates, allowDuplicate) {
+			return 0, duplicates, errDuplicateBook
+		}
+		if err := insertBook(tx, &book); err != nil {
+			return 0, nil, err
+		}
+		return book.ID, duplicates, nil
+	}
+
+	book, err := findUserBook(tx, userID, uint64(operation.ID))
+	if err != nil {
+		return 0, nil, errors.New("kitap bulunamadı")
+	}
+	if operation.Version != 0 && operation.Version != book.Version {
+		return 0, nil, errVersionConflict
+	}
+	if err := bumpBookVersion(tx, &book); err != nil {
+		return 0, nil, err
+	}
+
+	if operation.Op == "update" {
+		before := book
+		if err := applyBookPatch(tx, &book, operation.patch); err != nil {
+			return 0, nil, err
+		}
+		return book.ID, nil, recordBookRevision(tx, before, book, models.RevisionUpdate, 0)
+	}
+	return book.ID, nil, tx.Delete(&book).Error
+}
+
+// notifyBatchGoalMilestones başarılı create işlemlerinin okuma yılları için
+// hedef eşiği bildirimlerini yayınlar
+func notifyBatchGoalMilestones(userID uint, prepared []preparedBookOperation, results []models.BookBatchResult) {
+	years := map[int]bool{}
+	for i, operation := range prepared {
+		if operation.Op == "create" && results[i].Status == "success" {
+			years[operation.book.ReadDate.Year()] = true
+		}
+	}
+	for year := range years {
+		notifyGoalMilestone(userID, year)
+	}
+}
+
+// CreateBookBatch godoc
+// @Summary      Toplu kitap işlemleri
+// @Description  Birden fazla create/update/delete işlemini tek istekte uygular. atomic=true (varsayılan) iken tüm işlemler tek transaction içinde uygulanır ve bir hata tümünü geri alır; atomic=false iken her işlemin sonucu ayrı döner. create işlemleri kitap eklemedeki kopya denetiminden geçer; engellenen veya benzer kitabı olan işlemlerin sonucunda olası kopyalar duplicates alanında döner.
+// @Tags         books
+// @Accept       json
+// @Produce      json
+// @Param        batch            body      models.BookBatchRequest  true   "Toplu işlemler"
+// @Param        allow_duplicate  query     bool                     false  "true ise aynı başlık ve yazara sahip kitaplar yine de eklenir (aynı ISBN'e izin verilmez)"
+// @Success      200    {array}   models.BookBatchResult
+// @Failure      400    {object}  map[string]interface{}
+// @Failure      401    {object}  map[string]interface{}
+// @Failure      409    {object}  map[string]interface{}
+// @Failure      412    {object}  map[string]interface{}
+// @Failure      413    {object}  map[string]interface{}
+// @Failure      500    {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/batch [post]
+func CreateBookBatch(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	var request models.BookBatchRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	maxSize := bookBatchMaxSize()
+	if len(request.Operations) > maxSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+			"status":  "error",
+			"message": "Toplu istek çok büyük",
+			"error":   fmt.Sprintf("En fazla %d işlem gönderilebilir", maxSize),
+		})
+		return
+	}
+	atomic := request.Atomic == nil || *request.Atomic
+	allowDuplicate := c.Query("allow_duplicate") == "true"
+
+	// Tüm işlemleri önce doğrula
+	results := make([]models.BookBatchResult, len(request.Operations))
+	prepared := make([]preparedBookOperation, len(request.Operations))
+	valid := true
+	for i, operation := range request.Operations {
+		results[i] = models.BookBatchResult{Index: i, Op: operation.Op, ID: operation.ID}
+		var err error
+		prepared[i], err = prepareBookOperation(operation)
+		if err != nil {
+			results[i].Status = "error"
+			results[i].Error = err.Error()
+			valid = false
+		}
+	}
+
+	if atomic && !valid {
+		for i := range results {
+			if results[i].Status == "" {
+				results[i].Status = "skipped"
+			}
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz işlemler var, hiçbir işlem uygulanmadı",
+			"data":    results,
+		})
+		return
+	}
+
+	if atomic {
+		failed := -1
+		err := config.DB.Transaction(func(tx *gorm.DB) error {
+			for i, operation := range prepared {
+				id, duplicates, err := executeBookOperation(tx, userID.(uint), operation, allowDuplicate)
+				results[i].Duplicates = duplicates
+				if err != nil {
+					failed = i
+					return err
+				}
+				results[i].ID = id
+				results[i].Status = "success"
+			}
+			return nil
+		})
+		if err != nil {
+			// Transaction geri alındı; başarılı görünen işlemler de uygulanmadı
+			for i := range results {
+				results[i].Status = "rolled_back"
+			}
+			results[failed].Status = "error"
+			results[failed].Error = err.Error()
+
+			status := http.StatusBadRequest
+			switch {
+			case errors.Is(err, errVersionConflict):
+				status = http.StatusPreconditionFailed
+			case errors.Is(err, errDuplicateBook), errors.Is(err, errDuplicateISBN), isDuplicateISBNError(err):
+				status = http.StatusConflict
+			}
+			c.JSON(status, gin.H{
+				"status":  "error",
+				"message": fmt.Sprintf("%d. işlem başarısız oldu, hiçbir işlem uygulanmadı", failed),
+				"data":    results,
+			})
+			return
+		}
+
+		notifyBatchGoalMilestones(userID.(uint), prepared, results)
+		c.JSON(http.StatusOK, gin.H{
+			"status":  "success",
+			"message": "Toplu işlemler başarıyla uygulandı",
+			"data":    results,
+		})
+		return
+	}
+
+	// Atomik olmayan modda her işlem kendi transaction'ında uygulanır
+	succeeded := 0
+	for i, operation := range prepared {
+		if results[i].Status == "error" {
+			continue
+		}
+		var id uint
+		err := config.DB.Transaction(func(tx *gorm.DB) error {
+			var err error
+			id, results[i].Duplicates, err = executeBookOperation(tx, userID.(uint), operation, allowDuplicate)
+			return err
+		})
+		if err != nil {
+			results[i].Status = "error"
+			results[i].Error = err.Error()
+			continue
+		}
+		results[i].ID = id
+		results[i].Status = "success"
+		succeeded++
+	}
+	notifyBatchGoalMilestones(userID.(uint), prepared, results)
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": fmt.Sprintf("%d/%d işlem başarıyla uygulandı", succeeded, len(results)),
+		"data":    results,
+	})
+}
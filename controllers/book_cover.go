@@ -0,0 +1,335 @@
+package controllers
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+
+	"go-api/config"
+	"go-api/models"
+	"go-api/utils"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const (
+	// defaultCoverMaxSizeMB BOOK_COVER_MAX_SIZE_MB verilmediğinde kabul edilen en büyük kapak
+	defaultCoverMaxSizeMB = 5
+	// coverThumbnailWidth küçük resimlerin piksel cinsinden genişliği
+	coverThumbnailWidth = 300
+)
+
+// coverMaxSize BOOK_COVER_MAX_SIZE_MB ortam değişkeninden en büyük kapak boyutunu okur
+func coverMaxSize() int64 {
+	size, err := strconv.Atoi(os.Getenv("BOOK_COVER_MAX_SIZE_MB"))
+	if err != nil || size <= 0 {
+		size = defaultCoverMaxSizeMB
+	}
+	return int64(size) << 20
+}
+
+// bookCoverURLs kitabın kapak ve küçük resim adreslerini döner; kapak yoksa boştur
+func bookCoverURLs(book models.Book) (string, string) {
+	if book.CoverKey == "" || config.Storage == nil {
+		return "", ""
+	}
+	return config.Storage.URL(book.CoverKey), config.Storage.URL(book.ThumbnailKey)
+}
+
+// deleteCoverFiles kapak dosyalarını depolamadan siler. Kayıt zaten
+// güncellendiği için hatalar yalnızca loglanır.
+func deleteCoverFiles(c *gin.Context, keys ...string) {
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		if err := config.Storage.Delete(c.Request.Context(), key); err != nil {
+			log.Printf("Kapak dosyası silinemedi (%s): %v", key, err)
+		}
+	}
+}
+
+// saveBookCover kapak anahtarlarını kaydeder ve kitabın sürümünü artırır
+func saveBookCover(book *models.Book, coverKey, thumbnailKey string) error {
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := bumpBookVersion(tx, book); err != nil {
+			return err
+		}
+		book.CoverKey = coverKey
+		book.ThumbnailKey = thumbnailKey
+		return tx.Model(book).Select("cover_key", "thumbnail_key").Updates(book).Error
+	})
+}
+
+// respondBookDetail kitabı okuma geçmişi, yazarları ve serisiyle yükleyip güncel haliyle döner
+func respondBookDetail(c *gin.Context, userID interface{}, book models.Book, message string) {
+	if err := config.DB.Preload("Reads", orderReads).Preload("Authors").Preload("Series").First(&book, book.ID).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kitap bilgileri alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var user models.User
+	if err := config.DB.First(&user, userID).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kullanıcı bilgileri alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.Header("ETag", bookETag(book))
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": message,
+		"data":    newBookResponse(book, user),
+	})
+}
+
+// UploadBookCover godoc
+// @Summary      Kitap kapağı yükleme
+// @Description  JPEG, PNG veya WebP kapak görselini yükler ve küçük resmini oluşturur. Tür, dosya içeriğinden belirlenir. Mevcut kapak değiştirilir.
+// @Tags         books
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id    path      int   true  "Kitap ID"
+// @Param        file  formData  file  true  "Kapak görseli"
+// @Success      200   {object}  models.BookResponse
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      404   {object}  map[string]interface{}
+// @Failure      412   {object}  map[string]interface{}
+// @Failure      413   {object}  map[string]interface{}
+// @Failure      415   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/{id}/cover [put]
+func UploadBookCover(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	bookID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kitap ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	book, err := findUserBook(config.DB, userID, bookID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if !checkIfMatch(c, book) {
+		return
+	}
+
+	// Multipart başlıkları için sınırın üzerinde küçük bir pay bırakılır
+	maxSize := coverMaxSize()
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxSize+1<<20)
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+				"status":  "error",
+				"message": "Dosya çok büyük",
+				"error":   fmt.Sprintf("kapak en fazla %d MB olabilir", maxSize>>20),
+			})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Dosya alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if fileHeader.Size > maxSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+			"status":  "error",
+			"message": "Dosya çok büyük",
+			"error":   fmt.Sprintf("kapak en fazla %d MB olabilir", maxSize>>20),
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Dosya açılamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Dosya okunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	contentType, err := utils.DetectImageType(data)
+	if err != nil {
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{
+			"status":  "error",
+			"message": "Desteklenmeyen dosya türü",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	thumbnail, err := utils.MakeThumbnail(data, coverThumbnailWidth)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Görsel işlenemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Her yüklemede yeni anahtar kullanılır; böylece önbellekteki eski kapak gösterilmez
+	suffix := make([]byte, 8)
+	if _, err := rand.Read(suffix); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kapak kaydedilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+	prefix := fmt.Sprintf("covers/%d/%d-%s", book.UserID, book.ID, hex.EncodeToString(suffix))
+	coverKey := prefix + "." + utils.ImageExtensions[contentType]
+	thumbnailKey := prefix + "-thumb.jpg"
+
+	ctx := c.Request.Context()
+	if err := config.Storage.Put(ctx, coverKey, bytes.NewReader(data), int64(len(data)), contentType); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kapak kaydedilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if err := config.Storage.Put(ctx, thumbnailKey, bytes.NewReader(thumbnail), int64(len(thumbnail)), "image/jpeg"); err != nil {
+		deleteCoverFiles(c, coverKey)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kapak kaydedilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	oldCoverKey, oldThumbnailKey := book.CoverKey, book.ThumbnailKey
+	if err := saveBookCover(&book, coverKey, thumbnailKey); err != nil {
+		deleteCoverFiles(c, coverKey, thumbnailKey)
+		respondBookSaveError(c, err, "Kapak kaydedilemedi")
+		return
+	}
+	deleteCoverFiles(c, oldCoverKey, oldThumbnailKey)
+
+	respondBookDetail(c, userID, book, "Kapak başarıyla yüklendi")
+}
+
+// DeleteBookCover godoc
+// @Summary      Kitap kapağını silme
+// @Description  Kitabın kapak görselini ve küçük resmini siler
+// @Tags         books
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Kitap ID"
+// @Success      200  {object}  models.BookResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      412  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/{id}/cover [delete]
+func DeleteBookCover(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	bookID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kitap ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	book, err := findUserBook(config.DB, userID, bookID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if book.CoverKey == "" {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitabın kapağı yok",
+			"error":   "kapak bulunamadı",
+		})
+		return
+	}
+
+	if !checkIfMatch(c, book) {
+		return
+	}
+
+	oldCoverKey, oldThumbnailKey := book.CoverKey, book.ThumbnailKey
+	if err := saveBookCover(&book, "", ""); err != nil {
+		respondBookSaveError(c, err, "Kapak silinemedi")
+		return
+	}
+	deleteCoverFiles(c, oldCoverKey, oldThumbnailKey)
+
+	respondBookDetail(c, userID, book, "Kapak başarıyla silindi")
+}
@@ -0,0 +1,612 @@
+package controllers
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"go-api/config"
+	"go-api/models"
+	"go-api/utils"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// errDuplicateBook kütüphanede aynı ISBN'e veya birebir aynı başlık ve yazara
+// sahip kitap varken eklemeyi engeller
+var errDuplicateBook = errors.New("kütüphanede aynı kitap zaten var")
+
+// Benzer sayılmak için başlık ve yazar benzerliğinin ulaşması gereken eşikler
+const (
+	duplicateTitleThreshold  = 0.85
+	duplicateAuthorThreshold = 0.8
+)
+
+// duplicateTitleNoise başlıklardaki parantez içi seri ve baskı bilgileri,
+// ör. "Dune (Dune Chronicles #1)"
+var duplicateTitleNoise = regexp.MustCompile(`\([^)]*\)|\[[^\]]*\]`)
+
+// duplicateTitle başlığı seri bilgisinden ve yazım farklarından arındırır
+func duplicateTitle(title string) string {
+	return normalizeDuplicateText(duplicateTitleNoise.ReplaceAllString(title, ""))
+}
+
+// duplicateAuthors yazar metnini "Soyad, Ad" ve sıra farklarından arındırır
+func duplicateAuthors(author string) string {
+	var keys []string
+	for _, name := range utils.SplitAuthorNames(author) {
+		keys = append(keys, utils.AuthorNameKey(name))
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, "")
+}
+
+// authorSimilarity yazarların benzerliğini döner; yalnızca soyadı yazılmış
+// yazar tam adla eşleşir (ör. "Herbert" ve "Frank Herbert"). Uzunluk farkı
+// eşiğe ulaşmayı imkânsız kılıyorsa mesafe hesaplanmadan 0 döner.
+func authorSimilarity(a, b string) float64 {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	if len(a) >= 4 && strings.HasSuffix(b, a) {
+		return 1
+	}
+	shorter, longer := utf8.RuneCountInString(a), utf8.RuneCountInString(b)
+	if longer > 0 && 1-float64(longer-shorter)/float64(longer) < duplicateAuthorThreshold {
+		return 0
+	}
+	return utils.Similarity(a, b)
+}
+
+// duplicateEntry kopya karşılaştırması için bir kez normalize edilmiş kitap
+type duplicateEntry struct {
+	book    models.Book
+	key     string
+	title   string
+	authors string
+}
+
+// newDuplicateEntry kitabın karşılaştırma anahtarlarını hesaplar
+func newDuplicateEntry(book models.Book) duplicateEntry {
+	return duplicateEntry{
+		book:    book,
+		key:     bookDuplicateKey(book.Title, book.Author),
+		title:   duplicateTitle(book.Title),
+		authors: duplicateAuthors(book.Author),
+	}
+}
+
+// bookSimilarity iki kitabın birbirinin kopyası olup olmadığını, benzerliğini
+// ve eşleşme nedenini döner
+func bookSimilarity(a, b duplicateEntry) (float64, string, bool) {
+	if a.book.ISBN13 != "" && a.book.ISBN13 == b.book.ISBN13 {
+		return 1, models.DuplicateISBN, true
+	}
+	if a.key == b.key {
+		return 1, models.DuplicateExact, true
+	}
+	return titleAuthorSimilarity(a, b, authorSimilarity(a.authors, b.authors))
+}
+
+// titleAuthorSimilarity yazar benzerliği bilinen iki kitabın başlıklarını karşılaştırır
+func titleAuthorSimilarity(a, b duplicateEntry, authorScore float64) (float64, string, bool) {
+	if authorScore < duplicateAuthorThreshold {
+		return 0, "", false
+	}
+	titleScore := utils.Similarity(a.title, b.title)
+	if titleScore < duplicateTitleThreshold {
+		return 0, "", false
+	}
+	return math.Round((titleScore+authorScore)/2*1000) / 1000, models.DuplicateTitleAuthor, true
+}
+
+// newDuplicateBook kitabın kopya raporundaki özetini döner
+func newDuplicateBook(book models.Book) models.DuplicateBook {
+	return models.DuplicateBook{
+		ID:       book.ID,
+		Title:    book.Title,
+		Author:   book.Author,
+		ISBN13:   book.ISBN13,
+		ReadDate: book.ReadDate,
+		Rating:   book.Rating,
+	}
+}
+
+// userDuplicateBooks kopya karşılaştırması için kullanıcının çöp kutusunda
+// olmayan kitaplarını döner
+func userDuplicateBooks(tx *gorm.DB, userID interface{}) ([]models.Book, error) {
+	var books []models.Book
+	err := tx.Select("id", "title", "author", "isbn13", "read_date", "rating").
+		Where("user_id = ?", userID).Order("id").Find(&books).Error
+	return books, err
+}
+
+// findDuplicateCandidates kitabın kütüphanedeki olası kopyalarını en benzerden
+// başlayarak döner. book.ISBN13 normalize edilmiş olmalıdır.
+func findDuplicateCandidates(tx *gorm.DB, book models.Book) ([]models.DuplicateCandidate, error) {
+	books, err := userDuplicateBooks(tx, book.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := newDuplicateEntry(book)
+	candidates := []models.DuplicateCandidate{}
+	for _, existing := range books {
+		if existing.ID == book.ID {
+			continue
+		}
+		if score, reason, ok := bookSimilarity(entry, newDuplicateEntry(existing)); ok {
+			candidates = append(candidates, models.DuplicateCandidate{
+				DuplicateBook: newDuplicateBook(existing),
+				Score:         score,
+				Reason:        reason,
+			})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+	return candidates, nil
+}
+
+// blocksBookCreate adaylar arasında eklemeyi engelleyen kopya olup olmadığını
+// döner. Aynı ISBN her zaman, birebir aynı başlık/yazar ise allowDuplicate
+// verilmedikçe engeller.
+func blocksBookCreate(candidates []models.DuplicateCandidate, allowDuplicate bool) bool {
+	for _, candidate := range candidates {
+		switch candidate.Reason {
+		case models.DuplicateISBN:
+			return true
+		case models.DuplicateExact:
+			if !allowDuplicate {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mergeTags etiketleri sırayı koruyarak, büyük/küçük harf farkı gözetmeden birleştirir
+func mergeTags(target []string, sources ...[]string) []string {
+	merged := []string{}
+	seen := map[string]bool{}
+	for _, tags := range append([][]string{target}, sources...) {
+		for _, tag := range tags {
+			key := strings.ToLower(strings.TrimSpace(tag))
+			if key == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			merged = append(merged, tag)
+		}
+	}
+	return merged
+}
+
+// mergeNotes boş olmayan, birbirinden farklı notları boş satırla ayırarak birleştirir
+func mergeNotes(notes ...string) string {
+	var parts []string
+	seen := map[string]bool{}
+	for _, note := range notes {
+		note = strings.TrimSpace(note)
+		if note == "" || seen[note] {
+			continue
+		}
+		seen[note] = true
+		parts = append(parts, note)
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// mergeClubBooks kaynak kitaplara başvuran kulüp kitaplarını hedefe taşır.
+// Kulübün listesinde hedef (ya da daha önce taşınan bir kaynak) zaten varsa
+// kayıt taşınmaz; gönderileri kalan kayda aktarılıp kayıt silinir.
+func mergeClubBooks(tx *gorm.DB, bookID uint, sourceIDs []uint) error {
+	var clubBooks []models.ClubBook
+	if err := tx.Where("book_id = ? OR book_id IN ?", bookID, sourceIDs).Order("id").Find(&clubBooks).Error; err != nil {
+		return err
+	}
+	// Hedefe başvuran kayıtlar önce gelir, böylece kulüpte tutulan kayıt onlardır
+	sort.SliceStable(clubBooks, func(i, j int) bool {
+		return *clubBooks[i].BookID == bookID && *clubBooks[j].BookID != bookID
+	})
+
+	kept := map[uint]*models.ClubBook{}
+	for i := range clubBooks {
+		clubBook := &clubBooks[i]
+		existing, ok := kept[clubBook.ClubID]
+		if !ok {
+			kept[clubBook.ClubID] = clubBook
+			if *clubBook.BookID != bookID {
+				if err := tx.Model(clubBook).Update("book_id", bookID).Error; err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if *clubBook.BookID == bookID {
+			continue
+		}
+
+		err := tx.Model(&models.ClubPost{}).Where("club_book_id = ?", clubBook.ID).Update("club_book_id", existing.ID).Error
+		if err != nil {
+			return err
+		}
+		// Silinen kayıt kulübün güncel kitabıysa kalan kayıt onun yerini alır
+		if clubBook.Status == models.ClubBookCurrent && existing.Status != models.ClubBookCurrent {
+			if err := tx.Model(existing).Update("status", models.ClubBookCurrent).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Delete(clubBook).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeHighlights kaynak kitapların vurgularını hedefe taşır. Vurgu anahtarı
+// kitap ID'sini içerdiğinden yeniden hesaplanır; hedefte aynı vurgu zaten
+// varsa taşınan kopya silinir.
+func mergeHighlights(tx *gorm.DB, bookID uint, sourceIDs []uint) error {
+	var highlights []models.Highlight
+	if err := tx.Where("book_id IN ?", sourceIDs).Order("id").Find(&highlights).Error; err != nil {
+		return err
+	}
+	for _, highlight := range highlights {
+		hash := highlightHash(bookID, highlight.Location, highlight.Text)
+		var count int64
+		err := tx.Model(&models.Highlight{}).Where("user_id = ? AND hash = ?", highlight.UserID, hash).Count(&count).Error
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			err = tx.Delete(&highlight).Error
+		} else {
+			err = tx.Model(&highlight).Updates(map[string]interface{}{"book_id": bookID, "hash": hash}).Error
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetBookDuplicates godoc
+// @Summary      Kopya kitap raporu
+// @Description  Kütüphanedeki olası kopya kitapları gruplar. Aynı ISBN-13'e sahip, başlık ve yazarı birebir aynı veya birbirine çok benzeyen kitaplar aynı gruba girer.
+// @Tags         books
+// @Accept       json
+// @Produce      json
+// @Success      200  {array}   models.DuplicateGroup
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/duplicates [get]
+func GetBookDuplicates(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	books, err := userDuplicateBooks(config.DB, userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kopya kitaplar bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Benzer kitap çiftleri birleşim-bul ile gruplanır; grup, en küçük
+	// indeksli kitabıyla temsil edilir
+	parent := make([]int, len(books))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	scores, reasons := map[int]float64{}, map[int]string{}
+	matched := map[int]bool{}
+	link := func(i, j int, score float64, reason string) {
+		matched[i], matched[j] = true, true
+		ri, rj := find(i), find(j)
+		if ri != rj {
+			if rj < ri {
+				ri, rj = rj, ri
+			}
+			parent[rj] = ri
+			if scores[rj] > scores[ri] {
+				scores[ri], reasons[ri] = scores[rj], reasons[rj]
+			}
+		}
+		if score > scores[ri] || reasons[ri] == "" {
+			scores[ri], reasons[ri] = score, reason
+		}
+	}
+
+	// Kitaplar bir kez normalize edilir ve yazar anahtarına göre kovalanır;
+	// aynı ISBN'li veya birebir aynı başlık/yazarlı kitaplar doğrudan eşleşir
+	entries := make([]duplicateEntry, len(books))
+	buckets, isbns, keys := map[string][]int{}, map[string][]int{}, map[string][]int{}
+	var authorKeys []string
+	for i, book := range books {
+		entries[i] = newDuplicateEntry(book)
+		if _, ok := buckets[entries[i].authors]; !ok {
+			authorKeys = append(authorKeys, entries[i].authors)
+		}
+		buckets[entries[i].authors] = append(buckets[entries[i].authors], i)
+		if book.ISBN13 != "" {
+			isbns[book.ISBN13] = append(isbns[book.ISBN13], i)
+		}
+		keys[entries[i].key] = append(keys[entries[i].key], i)
+	}
+	for _, reason := range []string{models.DuplicateISBN, models.DuplicateExact} {
+		groups := isbns
+		if reason == models.DuplicateExact {
+			groups = keys
+		}
+		for _, group := range groups {
+			for _, j := range group[1:] {
+				link(group[0], j, 1, reason)
+			}
+		}
+	}
+
+	// Başlıklar yalnızca yazarları benzeyen kovalardaki kitaplar arasında karşılaştırılır
+	for a, authorsA := range authorKeys {
+		for _, authorsB := range authorKeys[a:] {
+			authorScore := authorSimilarity(authorsA, authorsB)
+			if authorScore < duplicateAuthorThreshold {
+				continue
+			}
+			for _, i := range buckets[authorsA] {
+				for _, j := range buckets[authorsB] {
+					if authorsA == authorsB && j <= i {
+						continue
+					}
+					x, y := entries[i], entries[j]
+					if x.key == y.key || (x.book.ISBN13 != "" && x.book.ISBN13 == y.book.ISBN13) {
+						continue
+					}
+					if score, reason, ok := titleAuthorSimilarity(x, y, authorScore); ok {
+						link(i, j, score, reason)
+					}
+				}
+			}
+		}
+	}
+
+	groups := []models.DuplicateGroup{}
+	index := map[int]int{}
+	for i, book := range books {
+		if !matched[i] {
+			continue
+		}
+		root := find(i)
+		position, ok := index[root]
+		if !ok {
+			position = len(groups)
+			index[root] = position
+			groups = append(groups, models.DuplicateGroup{Score: scores[root], Reason: reasons[root]})
+		}
+		groups[position].Books = append(groups[position].Books, newDuplicateBook(book))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Kopya kitap raporu hazırlandı",
+		"data":    groups,
+	})
+}
+
+// MergeBooks godoc
+// @Summary      Kopya kitapları birleştirme
+// @Description  Kaynak kitapları hedef kitapla birleştirir: okuma geçmişi, vurgular, alıntılar, ödünç ve kulüp kayıtları, beğeniler, yorumlar, etkinlikler ve bildirimler hedefe taşınır; aynı kullanıcının beğenileri, hedefte zaten bulunan vurgular ve aynı kulüpteki kulüp kayıtları teke iner ve beğeni/yorum sayıları yeniden hesaplanır. Etiketler ve notlar birleştirilir; hedefte boş olan ISBN, sayfa sayısı, yayın yılı, seri ve kapak kaynaktan alınır. Kaynak kitaplar kalıcı olarak silinir; kaynakların revizyon geçmişi taşınmaz, birleşmiş hal hedefte merge revizyonu olarak kaydedilir.
+// @Tags         books
+// @Accept       json
+// @Produce      json
+// @Param        id        path      int                      true   "Hedef kitap ID"
+// @Param        If-Match  header    string                   false  "Hedef kitabın ETag değeri"
+// @Param        merge     body      models.BookMergeRequest  true   "Birleştirilecek kitaplar"
+// @Success      200       {object}  models.BookResponse
+// @Failure      400       {object}  map[string]interface{}
+// @Failure      401       {object}  map[string]interface{}
+// @Failure      404       {object}  map[string]interface{}
+// @Failure      412       {object}  map[string]interface{}
+// @Failure      500       {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/{id}/merge [post]
+func MergeBooks(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	bookID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kitap ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	book, err := findUserBook(config.DB, userID, bookID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if !checkIfMatch(c, book) {
+		return
+	}
+
+	var request models.BookMergeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+	sourceIDs := []uint{}
+	seen := map[uint]bool{}
+	for _, id := range request.SourceIDs {
+		if id == book.ID {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"status":  "error",
+				"message": "Geçersiz istek",
+				"error":   "kitap kendisiyle birleştirilemez",
+			})
+			return
+		}
+		if !seen[id] {
+			seen[id] = true
+			sourceIDs = append(sourceIDs, id)
+		}
+	}
+
+	var sources []models.Book
+	if err := config.DB.Where("id IN ? AND user_id = ?", sourceIDs, userID).Order("id").Find(&sources).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kitaplar birleştirilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if len(sources) != len(sourceIDs) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   "birleştirilecek kitaplardan bazıları bulunamadı",
+		})
+		return
+	}
+
+	before := book
+	notes := []string{book.Notes}
+	tags := [][]string{}
+	for _, source := range sources {
+		notes = append(notes, source.Notes)
+		tags = append(tags, source.Tags)
+	}
+	book.Notes = mergeNotes(notes...)
+	book.Tags = mergeTags(book.Tags, tags...)
+
+	// Hedefte boş olan bilgiler, sırayla ilk dolu kaynaktan alınır
+	var coverSource *models.Book
+	for i, source := range sources {
+		if book.ISBN13 == "" && book.ISBN10 == "" {
+			book.ISBN10, book.ISBN13 = source.ISBN10, source.ISBN13
+		}
+		if book.PageCount == 0 {
+			book.PageCount = source.PageCount
+		}
+		if book.PublishYear == 0 {
+			book.PublishYear = source.PublishYear
+		}
+		if book.SeriesID == nil && source.SeriesID != nil {
+			book.SeriesID, book.SeriesPosition = source.SeriesID, source.SeriesPosition
+		}
+		if book.CoverKey == "" && source.CoverKey != "" && coverSource == nil {
+			coverSource = &sources[i]
+			book.CoverKey, book.ThumbnailKey = source.CoverKey, source.ThumbnailKey
+		}
+	}
+
+	var coverKeys []string
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		// Aynı kullanıcının birden fazla kitaptaki beğenisi hedefte tek beğeniye iner
+		err := tx.Where(`book_id IN ? AND EXISTS (SELECT 1 FROM book_likes other WHERE other.user_id = book_likes.user_id
+			AND (other.book_id = ? OR (other.book_id IN ? AND other.id < book_likes.id)))`, sourceIDs, book.ID, sourceIDs).
+			Delete(&models.BookLike{}).Error
+		if err != nil {
+			return err
+		}
+		if err := mergeClubBooks(tx, book.ID, sourceIDs); err != nil {
+			return err
+		}
+		if err := mergeHighlights(tx, book.ID, sourceIDs); err != nil {
+			return err
+		}
+		children := []interface{}{
+			&models.BookRead{}, &models.Quote{}, &models.Loan{}, &models.BookLike{},
+			&models.BookComment{}, &models.Activity{}, &models.Notification{}, &models.ReviewAction{},
+		}
+		for _, child := range children {
+			if err := tx.Model(child).Where("book_id IN ?", sourceIDs).Update("book_id", book.ID).Error; err != nil {
+				return err
+			}
+		}
+		err = tx.Exec(`UPDATE books SET
+			like_count = (SELECT COUNT(*) FROM book_likes WHERE book_id = ?),
+			comment_count = (SELECT COUNT(*) FROM book_comments WHERE book_id = ?)
+			WHERE id = ?`, book.ID, book.ID, book.ID).Error
+		if err != nil {
+			return err
+		}
+		// Taşınan kapak dosyası kaynak kitapla birlikte silinmesin
+		if coverSource != nil {
+			err := tx.Model(coverSource).Updates(map[string]interface{}{"cover_key": "", "thumbnail_key": ""}).Error
+			if err != nil {
+				return err
+			}
+		}
+		keys, err := config.DeleteBooksPermanently(tx, sourceIDs)
+		if err != nil {
+			return err
+		}
+		coverKeys = keys
+
+		if err := checkBookISBN(tx, &book); err != nil {
+			return err
+		}
+		if err := bumpBookVersion(tx, &book); err != nil {
+			return err
+		}
+		if err := tx.Save(&book).Error; err != nil {
+			return err
+		}
+		if err := recordBookRevision(tx, before, book, models.RevisionMerge, 0); err != nil {
+			return err
+		}
+		return syncBookFromReads(tx, &book)
+	})
+	if err != nil {
+		respondBookSaveError(c, err, "Kitaplar birleştirilemedi")
+		return
+	}
+	config.DeleteCoverFiles(coverKeys)
+
+	respondBookDetail(c, userID, book, "Kitaplar başarıyla birleştirildi")
+}
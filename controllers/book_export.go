@@ -0,0 +1,302 @@
+package controllers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-api/config"
+	"go-api/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// exportBatchSize dışa aktarmada veritabanından tek seferde okunan kitap sayısı
+const exportBatchSize = 100
+
+// bookExporter bir dışa aktarma biçimini kitap kitap yazar
+type bookExporter interface {
+	ContentType() string
+	Extension() string
+	Begin(w io.Writer) error
+	Write(w io.Writer, book models.Book) error
+	End(w io.Writer) error
+}
+
+// newBookExporter format adına göre dışa aktarıcı döner
+func newBookExporter(format string) (bookExporter, bool) {
+	switch format {
+	case "csv":
+		return &csvBookExporter{}, true
+	case "goodreads":
+		return &csvBookExporter{goodreads: true}, true
+	case "json":
+		return &jsonBookExporter{}, true
+	case "md":
+		return &markdownBookExporter{}, true
+	}
+	return nil, false
+}
+
+// csvBookExporter düz CSV veya Goodreads içe aktarımıyla uyumlu CSV yazar
+type csvBookExporter struct {
+	goodreads bool
+	writer    *csv.Writer
+}
+
+var (
+	csvExportHeader = []string{
+		"id", "title", "author", "isbn13", "page_count", "publish_year", "rating",
+		"read_date", "read_count", "tags", "summary", "notes", "created_at", "updated_at",
+	}
+	goodreadsExportHeader = []string{
+		"Book Id", "Title", "Author", "Author l-f", "Additional Authors", "ISBN", "ISBN13",
+		"My Rating", "Average Rating", "Publisher", "Binding", "Number of Pages",
+		"Year Published", "Original Publication Year", "Date Read", "Date Added",
+		"Bookshelves", "Bookshelves with positions", "Exclusive Shelf", "My Review",
+		"Spoiler", "Private Notes", "Read Count", "Owned Copies",
+	}
+)
+
+func (e *csvBookExporter) ContentType() string { return "text/csv; charset=utf-8" }
+
+func (e *csvBookExporter) Extension() string {
+	if e.goodreads {
+		return "goodreads.csv"
+	}
+	return "csv"
+}
+
+func (e *csvBookExporter) Begin(w io.Writer) error {
+	e.writer = csv.NewWriter(w)
+	if e.goodreads {
+		return e.writer.Write(goodreadsExportHeader)
+	}
+	return e.writer.Write(csvExportHeader)
+}
+
+func (e *csvBookExporter) Write(w io.Writer, book models.Book) error {
+	readCount := strconv.Itoa(len(book.Reads))
+	var record []string
+	if e.goodreads {
+		record = []string{
+			"", book.Title, book.Author, "", "", goodreadsISBN(book.ISBN10), goodreadsISBN(book.ISBN13),
+			strconv.Itoa(book.Rating), "", "", "", optionalInt(book.PageCount),
+			optionalInt(book.PublishYear), "", book.ReadDate.Format("2006/01/02"), book.CreatedAt.Format("2006/01/02"),
+			strings.Join(book.Tags, ", "), "", "read", book.Summary,
+			"", book.Notes, readCount, "0",
+		}
+	} else {
+		record = []string{
+			strconv.FormatUint(uint64(book.ID), 10), book.Title, book.Author, book.ISBN13,
+			optionalInt(book.PageCount), optionalInt(book.PublishYear), strconv.Itoa(book.Rating),
+			book.ReadDate.Format(filterDateLayout), readCount,
+			strings.Join(book.Tags, ", "), book.Summary, book.Notes,
+			book.CreatedAt.Format(time.RFC3339), book.UpdatedAt.Format(time.RFC3339),
+		}
+	}
+	if err := e.writer.Write(record); err != nil {
+		return err
+	}
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+// goodreadsISBN ISBN'i Goodreads'in kullandığı ="..." biçiminde yazar; böylece
+// tablo programları baştaki sıfırları silmez
+func goodreadsISBN(isbn string) string {
+	return "=\"" + isbn + "\""
+}
+
+// optionalInt sıfır değerleri boş hücre olarak yazar
+func optionalInt(value int) string {
+	if value == 0 {
+		return ""
+	}
+	return strconv.Itoa(value)
+}
+
+func (e *csvBookExporter) End(w io.Writer) error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+// jsonBookExporter kitapları okuma geçmişiyle birlikte JSON dizisi olarak yazar
+type jsonBookExporter struct {
+	count int
+}
+
+func (e *jsonBookExporter) ContentType() string { return "application/json; charset=utf-8" }
+func (e *jsonBookExporter) Extension() string   { return "json" }
+
+func (e *jsonBookExporter) Begin(w io.Writer) error {
+	_, err := io.WriteString(w, "[")
+	return err
+}
+
+func (e *jsonBookExporter) Write(w io.Writer, book models.Book) error {
+	data, err := json.Marshal(models.BookExport{
+		ID:          book.ID,
+		Title:       book.Title,
+		Author:      book.Author,
+		Summary:     book.Summary,
+		ReadDate:    book.ReadDate,
+		Rating:      book.Rating,
+		Notes:       book.Notes,
+		Tags:        book.Tags,
+		ISBN10:      book.ISBN10,
+		ISBN13:      book.ISBN13,
+		PageCount:   book.PageCount,
+		PublishYear: book.PublishYear,
+		Reads:       book.Reads,
+		CreatedAt:   book.CreatedAt,
+		UpdatedAt:   book.UpdatedAt,
+	})
+	if err != nil {
+		return err
+	}
+	if e.count > 0 {
+		if _, err := io.WriteString(w, ","); err != nil {
+			return err
+		}
+	}
+	e.count++
+	_, err = w.Write(data)
+	return err
+}
+
+func (e *jsonBookExporter) End(w io.Writer) error {
+	_, err := io.WriteString(w, "]")
+	return err
+}
+
+// markdownBookExporter her kitap için özet ve notları içeren bir bölüm yazar
+type markdownBookExporter struct{}
+
+func (e *markdownBookExporter) ContentType() string { return "text/markdown; charset=utf-8" }
+func (e *markdownBookExporter) Extension() string   { return "md" }
+
+func (e *markdownBookExporter) Begin(w io.Writer) error {
+	_, err := io.WriteString(w, "# Kitaplarım\n")
+	return err
+}
+
+func (e *markdownBookExporter) Write(w io.Writer, book models.Book) error {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "\n## %s\n\n", book.Title)
+	fmt.Fprintf(&builder, "- **Yazar:** %s\n", book.Author)
+	fmt.Fprintf(&builder, "- **Puan:** %s (%d/5)\n", strings.Repeat("★", book.Rating)+strings.Repeat("☆", 5-book.Rating), book.Rating)
+	fmt.Fprintf(&builder, "- **Okuma tarihi:** %s\n", book.ReadDate.Format(filterDateLayout))
+	if len(book.Reads) > 1 {
+		fmt.Fprintf(&builder, "- **Okuma sayısı:** %d\n", len(book.Reads))
+	}
+	if len(book.Tags) > 0 {
+		tags := make([]string, len(book.Tags))
+		for i, tag := range book.Tags {
+			tags[i] = "#" + strings.ReplaceAll(tag, " ", "-")
+		}
+		fmt.Fprintf(&builder, "- **Etiketler:** %s\n", strings.Join(tags, " "))
+	}
+	if book.Summary != "" {
+		fmt.Fprintf(&builder, "\n### Özet\n\n%s\n", book.Summary)
+	}
+	if book.Notes != "" {
+		fmt.Fprintf(&builder, "\n### Notlar\n\n%s\n", book.Notes)
+	}
+
+	_, err := io.WriteString(w, builder.String())
+	return err
+}
+
+func (e *markdownBookExporter) End(w io.Writer) error { return nil }
+
+// ExportBooks godoc
+// @Summary      Kitapları dışa aktarma
+// @Description  Kullanıcının kitaplarını liste uç noktasıyla aynı filtreleri uygulayarak CSV, JSON, Markdown veya Goodreads uyumlu CSV olarak akış halinde indirir
+// @Tags         books
+// @Produce      text/csv
+// @Produce      json
+// @Produce      text/markdown
+// @Param        format      query     string  false  "csv, json, md veya goodreads (varsayılan csv)"
+// @Param        q           query     string  false  "Başlık veya yazarda arama"
+// @Param        author      query     string  false  "Yazar"
+// @Param        author_id   query     int     false  "Yazar ID"
+// @Param        series_id   query     int     false  "Seri ID"
+// @Param        tag         query     string  false  "Etiket"
+// @Param        min_rating  query     int     false  "En düşük puan"
+// @Param        max_rating  query     int     false  "En yüksek puan"
+// @Param        read_from   query     string  false  "Okuma tarihi başlangıcı (YYYY-MM-DD)"
+// @Param        read_to     query     string  false  "Okuma tarihi bitişi (YYYY-MM-DD)"
+// @Success      200  {file}    file
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/export [get]
+func ExportBooks(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	exporter, ok := newBookExporter(c.DefaultQuery("format", "csv"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz format",
+			"error":   "format csv, json, md veya goodreads olmalıdır",
+		})
+		return
+	}
+
+	query, err := applyBookFilters(c, config.DB.Where("user_id = ?", userID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz filtre",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.Header("Content-Type", exporter.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"books.%s\"", exporter.Extension()))
+	c.Status(http.StatusOK)
+
+	// Kitaplar parça parça okunup yazılır, tüm kütüphane belleğe alınmaz
+	w := c.Writer
+	if err := exporter.Begin(w); err != nil {
+		c.Error(err)
+		return
+	}
+
+	var books []models.Book
+	result := query.Preload("Reads", orderReads).FindInBatches(&books, exportBatchSize, func(tx *gorm.DB, batch int) error {
+		for _, book := range books {
+			if err := exporter.Write(w, book); err != nil {
+				return err
+			}
+		}
+		w.Flush()
+		return nil
+	})
+	if result.Error != nil {
+		// Başlıklar gönderildiği için hata yalnızca loglanabilir
+		c.Error(result.Error)
+		return
+	}
+
+	if err := exporter.End(w); err != nil {
+		c.Error(err)
+	}
+}
@@ -0,0 +1,92 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// filterDateLayout tarih filtrelerinin beklenen biçimi
+const filterDateLayout = "2006-01-02"
+
+// likeEscaper LIKE desenlerinde joker karakterleri ve kaçış karakterini etkisizleştirir
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// containsPattern değeri içeren metinleri bulan LIKE deseni döner; desen
+// "ESCAPE '\'" ile kullanılmalıdır
+func containsPattern(value string) string {
+	return "%" + likeEscaper.Replace(value) + "%"
+}
+
+// tagCondition JSON dizisi olarak saklanan etiket sütununda etiketi arayan
+// koşulu döner. Etiket, encoding/json'ın yazdığı biçimle (ör. & → \u0026)
+// aranır.
+func tagCondition(column, tag string) (string, string) {
+	needle, _ := json.Marshal(tag)
+	return column + ` LIKE ? ESCAPE '\'`, containsPattern(string(needle))
+}
+
+// applyBookFilters kitap listesi ve dışa aktarma için ortak sorgu filtrelerini uygular:
+// q (başlık veya yazar), author, author_id, series_id, tag, min_rating, max_rating, read_from, read_to
+func applyBookFilters(c *gin.Context, query *gorm.DB) (*gorm.DB, error) {
+	if q := c.Query("q"); q != "" {
+		pattern := containsPattern(q)
+		query = query.Where(`(title LIKE ? ESCAPE '\' OR author LIKE ? ESCAPE '\')`, pattern, pattern)
+	}
+	if author := c.Query("author"); author != "" {
+		query = query.Where(`author LIKE ? ESCAPE '\'`, containsPattern(author))
+	}
+	if value := c.Query("author_id"); value != "" {
+		authorID, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("geçersiz author_id: %q", value)
+		}
+		query = query.Where("id IN (SELECT book_id FROM book_authors WHERE author_id = ?)", authorID)
+	}
+	if value := c.Query("series_id"); value != "" {
+		seriesID, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("geçersiz series_id: %q", value)
+		}
+		query = query.Where("series_id = ?", seriesID)
+	}
+	if tag := c.Query("tag"); tag != "" {
+		// Etiketler JSON dizisi olarak saklanır
+		condition, pattern := tagCondition("tags", tag)
+		query = query.Where(condition, pattern)
+	}
+
+	for param, operator := range map[string]string{"min_rating": ">=", "max_rating": "<="} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		rating, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("geçersiz %s: %q", param, value)
+		}
+		query = query.Where("rating "+operator+" ?", rating)
+	}
+
+	if value := c.Query("read_from"); value != "" {
+		from, err := time.Parse(filterDateLayout, value)
+		if err != nil {
+			return nil, fmt.Errorf("geçersiz read_from: %q", value)
+		}
+		query = query.Where("read_date >= ?", from)
+	}
+	if value := c.Query("read_to"); value != "" {
+		to, err := time.Parse(filterDateLayout, value)
+		if err != nil {
+			return nil, fmt.Errorf("geçersiz read_to: %q", value)
+		}
+		query = query.Where("read_date < ?", to.AddDate(0, 0, 1))
+	}
+
+	return query, nil
+}
@@ -0,0 +1,398 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"go-api/config"
+	"go-api/models"
+	"go-api/utils"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const (
+	// defaultImportAsyncThreshold bu satır sayısının üzerindeki dosyalar arka planda işlenir
+	defaultImportAsyncThreshold = 200
+	// maxImportFileSize içe aktarılabilecek en büyük dosya boyutu
+	maxImportFileSize = 20 << 20
+	// importProgressInterval arka plandaki işin ilerlemesinin kaydedilme sıklığı
+	importProgressInterval = 100
+)
+
+// importAsyncThreshold BOOK_IMPORT_ASYNC_THRESHOLD ortam değişkeninden eşiği okur
+func importAsyncThreshold() int {
+	threshold, err := strconv.Atoi(os.Getenv("BOOK_IMPORT_ASYNC_THRESHOLD"))
+	if err != nil || threshold <= 0 {
+		return defaultImportAsyncThreshold
+	}
+	return threshold
+}
+
+// normalizeDuplicateText metni büyük/küçük harf, noktalama ve boşluk
+// farklarından arındırır
+func normalizeDuplicateText(value string) string {
+	var builder strings.Builder
+	for _, r := range strings.ToLower(value) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+// bookDuplicateKey başlık ve yazarı büyük/küçük harf, noktalama ve boşluk
+// farklarından arındırarak karşılaştırma anahtarı üretir
+func bookDuplicateKey(title, author string) string {
+	return normalizeDuplicateText(title) + "|" + normalizeDuplicateText(author)
+}
+
+// isbnDuplicateKey ISBN-13 için userBookKeys'te kullanılan anahtar
+func isbnDuplicateKey(isbn13 string) string {
+	return "isbn:" + isbn13
+}
+
+// userBookKeys kullanıcının mevcut kitaplarının başlık/yazar ve ISBN
+// karşılaştırma anahtarlarını döner
+func userBookKeys(userID uint) (map[string]uint, error) {
+	var books []models.Book
+	if err := config.DB.Select("id", "title", "author", "isbn13").Where("user_id = ?", userID).Find(&books).Error; err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]uint, len(books))
+	for _, book := range books {
+		keys[bookDuplicateKey(book.Title, book.Author)] = book.ID
+		if book.ISBN13 != "" {
+			keys[isbnDuplicateKey(book.ISBN13)] = book.ID
+		}
+	}
+	return keys, nil
+}
+
+// importBookRow tek bir CSV satırını doğrular ve dry run değilse kitabı
+// oluşturur. Kindle içe aktarmasında olduğu gibi puansız kitaplar Rating 0
+// ile eklenir.
+func importBookRow(job *models.ImportJob, row utils.BookCSVRow, existing map[string]uint) models.ImportRowResult {
+	book := row.Book
+	result := models.ImportRowResult{Row: row.Line, Title: book.Title, Author: book.Author}
+
+	switch {
+	case row.Err != nil:
+		result.Status = models.ImportRowError
+		result.Message = row.Err.Error()
+	case row.Shelf != "" && row.Shelf != "read":
+		result.Status = models.ImportRowSkipped
+		result.Message = fmt.Sprintf("Yalnızca okunmuş kitaplar içe aktarılır (raf: %s)", row.Shelf)
+	case book.Title == "" || book.Author == "":
+		result.Status = models.ImportRowError
+		result.Message = "Başlık ve yazar zorunludur"
+	case book.Rating < 0 || book.Rating > 5:
+		result.Status = models.ImportRowError
+		result.Message = "Puan 0 ile 5 arasında olmalıdır"
+	}
+	if result.Status != "" {
+		return result
+	}
+
+	// Birbiriyle uyuşmayan ISBN'ler kitabı reddetmek yerine yok sayılır
+	if normalizeBookISBN(&book) != nil {
+		book.ISBN10, book.ISBN13 = "", ""
+	}
+
+	keys := []string{bookDuplicateKey(book.Title, book.Author)}
+	if book.ISBN13 != "" {
+		keys = append(keys, isbnDuplicateKey(book.ISBN13))
+	}
+	for _, key := range keys {
+		if id, ok := existing[key]; ok {
+			result.Status = models.ImportRowDup
+			result.BookID = id
+			result.Message = "Bu kitap kütüphanede zaten var"
+			return result
+		}
+	}
+
+	if job.DryRun {
+		for _, key := range keys {
+			existing[key] = 0
+		}
+		result.Status = models.ImportRowPreview
+		return result
+	}
+
+	book.UserID = job.UserID
+	book.Reads = []models.BookRead{{ReadDate: book.ReadDate, Rating: book.Rating}}
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&book).Error; err != nil {
+			return err
+		}
+		return config.SyncBookAuthors(tx, &book)
+	})
+	if err != nil {
+		result.Status = models.ImportRowError
+		result.Message = err.Error()
+		return result
+	}
+
+	for _, key := range keys {
+		existing[key] = book.ID
+	}
+	result.Status = models.ImportRowImported
+	result.BookID = book.ID
+	return result
+}
+
+// runBookImport tüm satırları işler ve iş raporunu doldurur. onProgress nil
+// değilse belirli aralıklarla çağrılır. Eklenen kitapların okuma yılları için
+// hedef eşiği bildirimleri yayınlanır.
+func runBookImport(job *models.ImportJob, rows []utils.BookCSVRow, onProgress func(*models.ImportJob)) {
+	existing, err := userBookKeys(job.UserID)
+	if err != nil {
+		job.Status = models.ImportJobFailed
+		job.Error = err.Error()
+		return
+	}
+
+	// Okuma tarihi olmayan satırlar içe aktarma tarihiyle eklenir
+	startedAt := time.Now()
+	years := map[int]bool{}
+	for i, row := range rows {
+		if row.Book.ReadDate.IsZero() {
+			row.Book.ReadDate = startedAt
+		}
+		result := importBookRow(job, row, existing)
+		if result.Status == models.ImportRowImported {
+			years[row.Book.ReadDate.Year()] = true
+		}
+		job.AddResult(result)
+		if onProgress != nil && (i+1)%importProgressInterval == 0 {
+			onProgress(job)
+		}
+	}
+
+	now := time.Now()
+	job.Status = models.ImportJobCompleted
+	job.FinishedAt = &now
+	for year := range years {
+		notifyGoalMilestone(job.UserID, year)
+	}
+}
+
+// runBackgroundImport içe aktarma işini arka planda çalıştırır ve durumunu iş
+// kaydına yazar. Beklenmeyen bir hata (panic) veya kaydedilemeyen durum işi
+// failed olarak bırakır; iş her durumda bildirimle sonuçlanır.
+func runBackgroundImport(job models.ImportJob, rows []utils.BookCSVRow) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			log.Printf("İçe aktarma işi %d beklenmedik şekilde durdu: %v", job.ID, recovered)
+			failImportJob(&job, fmt.Sprint(recovered))
+		}
+		if err := notifyImportFinished(job); err != nil {
+			log.Println("İçe aktarma bildirimi gönderilemedi:", err)
+		}
+	}()
+
+	job.Status = models.ImportJobRunning
+	if err := config.DB.Save(&job).Error; err != nil {
+		failImportJob(&job, err.Error())
+		return
+	}
+	runBookImport(&job, rows, func(job *models.ImportJob) {
+		if err := config.DB.Save(job).Error; err != nil {
+			log.Printf("İçe aktarma işi %d ilerlemesi kaydedilemedi: %v", job.ID, err)
+		}
+	})
+	if err := config.DB.Save(&job).Error; err != nil {
+		failImportJob(&job, err.Error())
+	}
+}
+
+// failImportJob işi verilen hata ile failed olarak kaydeder
+func failImportJob(job *models.ImportJob, message string) {
+	now := time.Now()
+	job.Status = models.ImportJobFailed
+	job.Error = message
+	job.FinishedAt = &now
+	err := config.DB.Model(job).Updates(map[string]interface{}{
+		"status":      job.Status,
+		"error":       job.Error,
+		"finished_at": job.FinishedAt,
+	}).Error
+	if err != nil {
+		log.Printf("İçe aktarma işi %d kaydedilemedi: %v", job.ID, err)
+	}
+}
+
+// ImportBooks godoc
+// @Summary      CSV içe aktarma
+// @Description  Goodreads veya StoryGraph CSV dışa aktarımını kitaplığa aktarır. Yalnızca okunmuş raftaki kitaplar eklenir, mevcut kitaplarla aynı başlık ve yazara sahip satırlar atlanır. Puansız satırlar 0 puanla, okuma tarihi olmayanlar içe aktarma tarihiyle eklenir. dry_run=true ile hiçbir kayıt oluşturulmadan önizleme döner. Büyük dosyalar veya async=true arka planda işlenir ve 202 ile iş bilgisi döner.
+// @Tags         books
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        file     formData  file    true   "CSV dosyası"
+// @Param        source   query     string  false  "goodreads veya storygraph (boşsa otomatik belirlenir)"
+// @Param        dry_run  query     bool    false  "Önizleme modu"
+// @Param        async    query     bool    false  "Arka planda işle"
+// @Success      200      {object}  models.ImportJob
+// @Success      201      {object}  models.ImportJob
+// @Success      202      {object}  models.ImportJob
+// @Failure      400      {object}  map[string]interface{}
+// @Failure      401      {object}  map[string]interface{}
+// @Failure      500      {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/import [post]
+func ImportBooks(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportFileSize)
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "CSV dosyası alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "CSV dosyası açılamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+
+	source, rows, err := utils.ParseBookCSV(file, strings.ToLower(c.Query("source")))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "CSV dosyası okunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	dryRun, _ := strconv.ParseBool(c.Query("dry_run"))
+	async, _ := strconv.ParseBool(c.Query("async"))
+	job := models.ImportJob{
+		UserID:    userID.(uint),
+		Source:    source,
+		Status:    models.ImportJobRunning,
+		DryRun:    dryRun,
+		TotalRows: len(rows),
+		Results:   []models.ImportRowResult{},
+	}
+
+	// Büyük dosyalar arka planda işlenir, ilerleme iş kaydından izlenir
+	if async || len(rows) > importAsyncThreshold() {
+		job.Status = models.ImportJobPending
+		if err := config.DB.Create(&job).Error; err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"status":  "error",
+				"message": "İçe aktarma işi oluşturulamadı",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		go runBackgroundImport(job, rows)
+
+		c.JSON(http.StatusAccepted, gin.H{
+			"status":  "success",
+			"message": "İçe aktarma arka planda başlatıldı",
+			"data":    job,
+		})
+		return
+	}
+
+	runBookImport(&job, rows, nil)
+	if err := config.DB.Create(&job).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "İçe aktarma raporu kaydedilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	status, message := http.StatusCreated, "Kitaplar başarıyla içe aktarıldı"
+	if dryRun {
+		status, message = http.StatusOK, "İçe aktarma önizlemesi hazırlandı"
+	}
+	c.JSON(status, gin.H{
+		"status":  "success",
+		"message": message,
+		"data":    job,
+	})
+}
+
+// GetImportJob godoc
+// @Summary      İçe aktarma durumu
+// @Description  İçe aktarma işinin durumunu ve satır bazlı raporunu getirir
+// @Tags         books
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "İş ID"
+// @Success      200  {object}  models.ImportJob
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/import/jobs/{id} [get]
+func GetImportJob(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	jobID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz iş ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var job models.ImportJob
+	if err := config.DB.Where("id = ? AND user_id = ?", jobID, userID).First(&job).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "İçe aktarma işi bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "İçe aktarma işi başarıyla getirildi",
+		"data":    job,
+	})
+}
@@ -0,0 +1,141 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"go-api/config"
+	"go-api/models"
+	"go-api/utils"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+var (
+	// errInvalidISBN ISBN biçimi veya kontrol basamağı hatalı olduğunda döner
+	errInvalidISBN = errors.New("geçersiz ISBN")
+	// errDuplicateISBN kullanıcının aynı ISBN'e sahip başka bir kitabı olduğunda döner
+	errDuplicateISBN = errors.New("bu ISBN ile kayıtlı bir kitap zaten var")
+)
+
+// isDuplicateISBNError eşzamanlı kayıtlarda checkBookISBN'i aşan ve
+// idx_books_user_isbn13 tekil indeksine takılan hatayı tanır
+func isDuplicateISBNError(err error) bool {
+	return strings.Contains(err.Error(), "UNIQUE constraint failed: books.user_id, books.isbn13")
+}
+
+// normalizeBookISBN kitabın ISBN'lerini normalize edip doğrular ve eksik olanı
+// diğerinden türetir. İki ISBN birlikte verilmişse aynı kitabı göstermelidir.
+func normalizeBookISBN(book *models.Book) error {
+	book.ISBN10 = utils.NormalizeISBN(book.ISBN10)
+	book.ISBN13 = utils.NormalizeISBN(book.ISBN13)
+
+	if book.ISBN10 != "" && !utils.ValidISBN10(book.ISBN10) {
+		return errInvalidISBN
+	}
+	if book.ISBN13 != "" && !utils.ValidISBN13(book.ISBN13) {
+		return errInvalidISBN
+	}
+
+	switch {
+	case book.ISBN10 != "" && book.ISBN13 == "":
+		book.ISBN13 = utils.ISBN10To13(book.ISBN10)
+	case book.ISBN13 != "" && book.ISBN10 == "":
+		book.ISBN10 = utils.ISBN13To10(book.ISBN13)
+	case book.ISBN10 != "" && utils.ISBN10To13(book.ISBN10) != book.ISBN13:
+		return errInvalidISBN
+	}
+	return nil
+}
+
+// checkBookISBN ISBN'leri normalize eder ve kullanıcının çöp kutusunda olmayan
+// başka bir kitabında aynı ISBN'in bulunmadığını doğrular
+func checkBookISBN(tx *gorm.DB, book *models.Book) error {
+	if err := normalizeBookISBN(book); err != nil {
+		return err
+	}
+	if book.ISBN13 == "" {
+		return nil
+	}
+
+	var count int64
+	err := tx.Model(&models.Book{}).
+		Where("user_id = ? AND isbn13 = ? AND id <> ?", book.UserID, book.ISBN13, book.ID).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errDuplicateISBN
+	}
+	return nil
+}
+
+// LookupBook godoc
+// @Summary      ISBN ile kitap bilgisi arama
+// @Description  ISBN-10 veya ISBN-13 ile yapılandırılmış sağlayıcıdan (Open Library veya yerel fixture) başlık, yazar, sayfa sayısı ve yayın yılını getirir. Kitap oluşturmaz.
+// @Tags         books
+// @Accept       json
+// @Produce      json
+// @Param        isbn  query     string  true  "ISBN-10 veya ISBN-13"
+// @Success      200   {object}  utils.BookMetadata
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      404   {object}  map[string]interface{}
+// @Failure      502   {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/lookup [post]
+func LookupBook(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	// Hem ISBN-10 hem ISBN-13 kabul edilir; arama her zaman ISBN-13 ile yapılır
+	book := models.Book{UserID: userID.(uint)}
+	isbn := utils.NormalizeISBN(c.Query("isbn"))
+	if len(isbn) == 10 {
+		book.ISBN10 = isbn
+	} else {
+		book.ISBN13 = isbn
+	}
+	if isbn == "" || normalizeBookISBN(&book) != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz ISBN",
+			"error":   "isbn geçerli bir ISBN-10 veya ISBN-13 olmalıdır",
+		})
+		return
+	}
+
+	metadata, err := config.Metadata.Lookup(c.Request.Context(), book.ISBN13)
+	if errors.Is(err, utils.ErrMetadataNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bilgisi bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{
+			"status":  "error",
+			"message": "Kitap bilgisi alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Kitap bilgisi başarıyla getirildi",
+		"data":    metadata,
+	})
+}
@@ -0,0 +1,396 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+
+	"go-api/config"
+	"go-api/models"
+	"go-api/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+// bookPatchColumns PATCH ile değiştirilebilen alanlar ve veritabanı kolonları
+var bookPatchColumns = map[string]string{
+	"title":           "title",
+	"author":          "author",
+	"summary":         "summary",
+	"read_date":       "read_date",
+	"rating":          "rating",
+	"notes":           "notes",
+	"tags":            "tags",
+	"isbn10":          "isbn10",
+	"isbn13":          "isbn13",
+	"page_count":      "page_count",
+	"publish_year":    "publish_year",
+	"series_id":       "series_id",
+	"series_position": "series_position",
+	"visibility":      "visibility",
+	"share_notes":     "share_notes",
+}
+
+// bookPatchRemovable silinmesine (null yapılmasına) izin verilen alanlar ve
+// silindiklerinde alacakları değer
+var bookPatchRemovable = map[string]interface{}{
+	"notes":           "",
+	"tags":            []interface{}{},
+	"isbn10":          "",
+	"isbn13":          "",
+	"page_count":      0,
+	"publish_year":    0,
+	"series_id":       0,
+	"series_position": 0,
+	"share_notes":     false,
+}
+
+// bookPatchDocument kitabın değiştirilebilir alanlarını patch uygulanacak
+// JSON belgesine çevirir. Serisi olmayan kitapta seri alanları 0'dır.
+func bookPatchDocument(book models.Book) (map[string]interface{}, error) {
+	var seriesID uint
+	var seriesPosition float64
+	if book.SeriesID != nil {
+		seriesID = *book.SeriesID
+	}
+	if book.SeriesPosition != nil {
+		seriesPosition = *book.SeriesPosition
+	}
+
+	data, err := json.Marshal(models.BookPatch{
+		Title:          &book.Title,
+		Author:         &book.Author,
+		Summary:        &book.Summary,
+		ReadDate:       &book.ReadDate,
+		Rating:         &book.Rating,
+		Notes:          &book.Notes,
+		Tags:           &book.Tags,
+		ISBN10:         &book.ISBN10,
+		ISBN13:         &book.ISBN13,
+		PageCount:      &book.PageCount,
+		PublishYear:    &book.PublishYear,
+		SeriesID:       &seriesID,
+		SeriesPosition: &seriesPosition,
+		Visibility:     &book.Visibility,
+		ShareNotes:     &book.ShareNotes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var doc map[string]interface{}
+	err = json.Unmarshal(data, &doc)
+	return doc, err
+}
+
+// bookPatchChanges patch sonrası belgeyi orijinal ile karşılaştırır ve yalnızca
+// değişen alanları döner. Beyaz listede olmayan veya silinemeyen alanlar hata verir.
+func bookPatchChanges(original, patched map[string]interface{}) (map[string]interface{}, error) {
+	changes := map[string]interface{}{}
+	for field, value := range patched {
+		if _, ok := bookPatchColumns[field]; !ok {
+			return nil, fmt.Errorf("%s alanı değiştirilemez", field)
+		}
+		if !reflect.DeepEqual(original[field], value) {
+			changes[field] = value
+		}
+	}
+
+	for field := range original {
+		if _, ok := patched[field]; ok {
+			continue
+		}
+		empty, ok := bookPatchRemovable[field]
+		if !ok {
+			return nil, fmt.Errorf("%s alanı silinemez", field)
+		}
+		changes[field] = empty
+	}
+	return changes, nil
+}
+
+// PatchBook godoc
+// @Summary      Kitap kısmi güncelleme
+// @Description  Kitabı RFC 7396 JSON Merge Patch (application/merge-patch+json veya application/json) ya da RFC 6902 JSON Patch (application/json-patch+json) ile kısmen günceller. Yalnızca title, author, summary, read_date, rating, notes, tags, isbn10, isbn13, page_count, publish_year, series_id, series_position, visibility ve share_notes değiştirilebilir; series_id 0 kitabı seriden çıkarır.
+// @Tags         books
+// @Accept       json
+// @Produce      json
+// @Param        id        path      int     true   "Kitap ID"
+// @Param        If-Match  header    string  false  "Kitabın güncel ETag değeri"
+// @Param        patch     body      object  true   "Merge patch nesnesi veya JSON Patch işlemleri"
+// @Success      200    {object}  models.BookResponse
+// @Failure      400    {object}  map[string]interface{}
+// @Failure      401    {object}  map[string]interface{}
+// @Failure      404    {object}  map[string]interface{}
+// @Failure      412    {object}  map[string]interface{}
+// @Failure      415    {object}  map[string]interface{}
+// @Failure      500    {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/{id} [patch]
+func PatchBook(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	bookID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kitap ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	book, err := findUserBook(config.DB, userID, bookID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if !checkIfMatch(c, book) {
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	original, err := bookPatchDocument(book)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kitap güncellenemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Patch türünü Content-Type belirler
+	var patched map[string]interface{}
+	switch c.ContentType() {
+	case utils.MergePatchContentType, binding.MIMEJSON:
+		patched, err = utils.ApplyMergePatch(original, body)
+	case utils.JSONPatchContentType:
+		patched, err = utils.ApplyJSONPatch(original, body)
+	default:
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{
+			"status":  "error",
+			"message": "Desteklenmeyen içerik türü",
+			"error":   "Content-Type application/merge-patch+json, application/json-patch+json veya application/json olmalıdır",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Patch uygulanamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	changes, err := bookPatchChanges(original, patched)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Yalnızca değişen alanları doğrula
+	var patch models.BookPatch
+	data, _ := json.Marshal(changes)
+	if err := json.Unmarshal(data, &patch); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if err := binding.Validator.ValidateStruct(&patch); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	before := book
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := bumpBookVersion(tx, &book); err != nil {
+			return err
+		}
+		if err := applyBookPatch(tx, &book, patch); err != nil {
+			return err
+		}
+		return recordBookRevision(tx, before, book, models.RevisionUpdate, 0)
+	})
+	if err != nil {
+		respondBookSaveError(c, err, "Kitap güncellenemedi")
+		return
+	}
+
+	// Kullanıcı bilgilerini al
+	var user models.User
+	if err := config.DB.First(&user, userID).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kullanıcı bilgileri alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Response hazırla
+	response := newBookResponse(book, user)
+
+	c.Header("ETag", bookETag(book))
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Kitap başarıyla güncellendi",
+		"data":    response,
+	})
+}
+
+// applyBookPatch patch'teki alanları kitaba yazar ve kaydeder; read_date veya
+// rating değiştiyse en son okuma kaydı, author değiştiyse yazar bağlantıları
+// da güncellenir. Okuma geçmişi, yazarlar ve seri her durumda kitaba yüklenir.
+func applyBookPatch(tx *gorm.DB, book *models.Book, patch models.BookPatch) error {
+	var columns []string
+	rating := book.Rating
+	if patch.Title != nil {
+		book.Title = *patch.Title
+		columns = append(columns, bookPatchColumns["title"])
+	}
+	if patch.Author != nil {
+		book.Author = *patch.Author
+		columns = append(columns, bookPatchColumns["author"])
+	}
+	if patch.Summary != nil {
+		book.Summary = *patch.Summary
+		columns = append(columns, bookPatchColumns["summary"])
+	}
+	if patch.ReadDate != nil {
+		book.ReadDate = *patch.ReadDate
+		columns = append(columns, bookPatchColumns["read_date"])
+	}
+	if patch.Rating != nil {
+		book.Rating = *patch.Rating
+		columns = append(columns, bookPatchColumns["rating"])
+	}
+	if patch.Notes != nil {
+		book.Notes = *patch.Notes
+		columns = append(columns, bookPatchColumns["notes"])
+	}
+	if patch.Tags != nil {
+		book.Tags = *patch.Tags
+		columns = append(columns, bookPatchColumns["tags"])
+	}
+	if patch.PageCount != nil {
+		book.PageCount = *patch.PageCount
+		columns = append(columns, bookPatchColumns["page_count"])
+	}
+	if patch.PublishYear != nil {
+		book.PublishYear = *patch.PublishYear
+		columns = append(columns, bookPatchColumns["publish_year"])
+	}
+	if patch.ShareNotes != nil {
+		book.ShareNotes = *patch.ShareNotes
+		columns = append(columns, bookPatchColumns["share_notes"])
+	}
+	if patch.Visibility != nil {
+		book.Visibility = *patch.Visibility
+		if err := applyBookVisibility(book); err != nil {
+			return err
+		}
+		columns = append(columns, bookPatchColumns["visibility"], "share_token")
+	}
+
+	// ISBN'lerden biri değişirse diğeri ondan yeniden türetilir; ikisi de yazılır
+	if patch.ISBN10 != nil || patch.ISBN13 != nil {
+		if patch.ISBN10 != nil {
+			book.ISBN10 = *patch.ISBN10
+		} else {
+			book.ISBN10 = ""
+		}
+		if patch.ISBN13 != nil {
+			book.ISBN13 = *patch.ISBN13
+		} else {
+			book.ISBN13 = ""
+		}
+		if err := checkBookISBN(tx, book); err != nil {
+			return err
+		}
+		columns = append(columns, bookPatchColumns["isbn10"], bookPatchColumns["isbn13"])
+	}
+
+	// Seri değişirse sıra yeni seriye göre doğrulanır; 0 seriden çıkarır
+	if patch.SeriesID != nil || patch.SeriesPosition != nil {
+		if patch.SeriesID != nil {
+			book.SeriesID = patch.SeriesID
+		}
+		if patch.SeriesPosition != nil {
+			book.SeriesPosition = patch.SeriesPosition
+			if *patch.SeriesPosition == 0 {
+				book.SeriesPosition = nil
+			}
+		}
+		if err := checkBookSeries(tx, book); err != nil {
+			return err
+		}
+		columns = append(columns, bookPatchColumns["series_id"], bookPatchColumns["series_position"])
+	} else if err := loadBookSeries(tx, book); err != nil {
+		return err
+	}
+
+	// Select ile yalnızca değişen kolonlar (boş değerler dahil) yazılır
+	if len(columns) > 0 {
+		if err := tx.Model(book).Select(columns).Updates(book).Error; err != nil {
+			return err
+		}
+	}
+
+	if patch.Author != nil {
+		if err := config.SyncBookAuthors(tx, book); err != nil {
+			return err
+		}
+	} else if err := loadBookAuthors(tx, book); err != nil {
+		return err
+	}
+
+	if patch.Rating != nil && *patch.Rating != rating {
+		if err := recordBookActivity(tx, *book, models.ActivityRated, book.Rating); err != nil {
+			return err
+		}
+	}
+	if patch.ReadDate != nil || patch.Rating != nil {
+		return updateLatestRead(tx, book)
+	}
+	return syncBookFromReads(tx, book)
+}
@@ -0,0 +1,432 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"go-api/config"
+	"go-api/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// orderReads okuma kayıtlarını yeniden eskiye sıralar
+func orderReads(db *gorm.DB) *gorm.DB {
+	return db.Order("read_date DESC, id DESC")
+}
+
+// findUserBook kullanıcıya ait kitabı getirir
+func findUserBook(db *gorm.DB, userID interface{}, bookID uint64) (models.Book, error) {
+	var book models.Book
+	err := db.Where("id = ? AND user_id = ?", bookID, userID).First(&book).Error
+	return book, err
+}
+
+// syncBookFromReads okuma geçmişini book.Reads'e yükler ve en son okumanın
+// tarih ve puanını kitaba yazar
+func syncBookFromReads(tx *gorm.DB, book *models.Book) error {
+	var reads []models.BookRead
+	if err := orderReads(tx.Where("book_id = ?", book.ID)).Find(&reads).Error; err != nil {
+		return err
+	}
+	book.Reads = reads
+	if len(reads) == 0 {
+		return nil
+	}
+
+	latest := reads[0]
+	if book.ReadDate.Equal(latest.ReadDate) && book.Rating == latest.Rating {
+		return nil
+	}
+	book.ReadDate = latest.ReadDate
+	book.Rating = latest.Rating
+	return tx.Model(book).Updates(map[string]interface{}{
+		"read_date": latest.ReadDate,
+		"rating":    latest.Rating,
+	}).Error
+}
+
+// updateLatestRead kitaba yazılan read_date ve rating değerlerini en son okuma
+// kaydına aktarır; kitabın hiç okuma kaydı yoksa yenisini oluşturur
+func updateLatestRead(tx *gorm.DB, book *models.Book) error {
+	var latest models.BookRead
+	err := orderReads(tx.Where("book_id = ?", book.ID)).First(&latest).Error
+	if err == gorm.ErrRecordNotFound {
+		latest = models.BookRead{BookID: book.ID}
+	} else if err != nil {
+		return err
+	}
+
+	latest.ReadDate = book.ReadDate
+	latest.Rating = book.Rating
+	if err := tx.Save(&latest).Error; err != nil {
+		return err
+	}
+	return syncBookFromReads(tx, book)
+}
+
+// GetBookReads godoc
+// @Summary      Okuma geçmişi
+// @Description  Kitabın tüm okuma kayıtlarını yeniden eskiye listeler
+// @Tags         books
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Kitap ID"
+// @Success      200  {array}   models.BookRead
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/{id}/reads [get]
+func GetBookReads(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	bookID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kitap ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	book, err := findUserBook(config.DB, userID, bookID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	reads := []models.BookRead{}
+	if err := orderReads(config.DB.Where("book_id = ?", book.ID)).Find(&reads).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Okuma kayıtları alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Okuma kayıtları başarıyla getirildi",
+		"data":    reads,
+	})
+}
+
+// CreateBookRead godoc
+// @Summary      Okuma ekleme
+// @Description  Kitaba yeni bir okuma kaydı ekler
+// @Tags         books
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int                     true  "Kitap ID"
+// @Param        read  body      models.BookReadRequest  true  "Okuma bilgileri"
+// @Success      201   {object}  models.BookRead
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      404   {object}  map[string]interface{}
+// @Failure      412   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/{id}/reads [post]
+func CreateBookRead(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	bookID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kitap ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	book, err := findUserBook(config.DB, userID, bookID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var request models.BookReadRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	read := models.BookRead{
+		BookID:    book.ID,
+		StartedAt: request.StartedAt,
+		ReadDate:  request.ReadDate,
+		Rating:    request.Rating,
+		Notes:     request.Notes,
+	}
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&read).Error; err != nil {
+			return err
+		}
+		if err := recordBookActivity(tx, book, models.ActivityFinished, read.Rating); err != nil {
+			return err
+		}
+		if err := bumpBookVersion(tx, &book); err != nil {
+			return err
+		}
+		return syncBookFromReads(tx, &book)
+	})
+	if err != nil {
+		respondBookSaveError(c, err, "Okuma kaydı eklenemedi")
+		return
+	}
+	notifyGoalMilestone(book.UserID, read.ReadDate.Year())
+
+	c.JSON(http.StatusCreated, gin.H{
+		"status":  "success",
+		"message": "Okuma kaydı başarıyla eklendi",
+		"data":    read,
+	})
+}
+
+// UpdateBookRead godoc
+// @Summary      Okuma güncelleme
+// @Description  Kitabın bir okuma kaydını günceller
+// @Tags         books
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int                     true  "Kitap ID"
+// @Param        readId  path      int                     true  "Okuma ID"
+// @Param        read    body      models.BookReadRequest  true  "Okuma bilgileri"
+// @Success      200     {object}  models.BookRead
+// @Failure      400     {object}  map[string]interface{}
+// @Failure      401     {object}  map[string]interface{}
+// @Failure      404     {object}  map[string]interface{}
+// @Failure      412     {object}  map[string]interface{}
+// @Failure      500     {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/{id}/reads/{readId} [put]
+func UpdateBookRead(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	bookID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kitap ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	readID, err := strconv.ParseUint(c.Param("readId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz okuma ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	book, err := findUserBook(config.DB, userID, bookID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var read models.BookRead
+	if err := config.DB.Where("id = ? AND book_id = ?", readID, book.ID).First(&read).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Okuma kaydı bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var request models.BookReadRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	rating := read.Rating
+	read.StartedAt = request.StartedAt
+	read.ReadDate = request.ReadDate
+	read.Rating = request.Rating
+	read.Notes = request.Notes
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&read).Error; err != nil {
+			return err
+		}
+		if read.Rating != rating {
+			if err := recordBookActivity(tx, book, models.ActivityRated, read.Rating); err != nil {
+				return err
+			}
+		}
+		if err := bumpBookVersion(tx, &book); err != nil {
+			return err
+		}
+		return syncBookFromReads(tx, &book)
+	})
+	if err != nil {
+		respondBookSaveError(c, err, "Okuma kaydı güncellenemedi")
+		return
+	}
+	notifyGoalMilestone(book.UserID, read.ReadDate.Year())
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Okuma kaydı başarıyla güncellendi",
+		"data":    read,
+	})
+}
+
+// DeleteBookRead godoc
+// @Summary      Okuma silme
+// @Description  Kitabın bir okuma kaydını siler; kitabın son okuma kaydı silinemez
+// @Tags         books
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int  true  "Kitap ID"
+// @Param        readId  path      int  true  "Okuma ID"
+// @Success      200     {object}  map[string]interface{}
+// @Failure      400     {object}  map[string]interface{}
+// @Failure      401     {object}  map[string]interface{}
+// @Failure      404     {object}  map[string]interface{}
+// @Failure      412     {object}  map[string]interface{}
+// @Failure      500     {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/{id}/reads/{readId} [delete]
+func DeleteBookRead(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	bookID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kitap ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	readID, err := strconv.ParseUint(c.Param("readId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz okuma ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	book, err := findUserBook(config.DB, userID, bookID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var read models.BookRead
+	if err := config.DB.Where("id = ? AND book_id = ?", readID, book.ID).First(&read).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Okuma kaydı bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var count int64
+	if err := config.DB.Model(&models.BookRead{}).Where("book_id = ?", book.ID).Count(&count).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Okuma kayıtları alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if count <= 1 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Okuma kaydı silinemedi",
+			"error":   "Kitabın en az bir okuma kaydı olmalıdır",
+		})
+		return
+	}
+
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&read).Error; err != nil {
+			return err
+		}
+		if err := bumpBookVersion(tx, &book); err != nil {
+			return err
+		}
+		return syncBookFromReads(tx, &book)
+	})
+	if err != nil {
+		respondBookSaveError(c, err, "Okuma kaydı silinemedi")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Okuma kaydı başarıyla silindi",
+	})
+}
@@ -0,0 +1,326 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"go-api/config"
+	"go-api/models"
+	"go-api/utils"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// recordBookRevision kitabın özeti veya notları değiştiyse yeni halini
+// revizyon olarak kaydeder. Kitabın henüz revizyonu yoksa önce değişiklikten
+// önceki hali initial revizyonu olarak yazılır.
+func recordBookRevision(tx *gorm.DB, before, after models.Book, source string, restoredFrom int) error {
+	if before.Summary == after.Summary && before.Notes == after.Notes {
+		return nil
+	}
+
+	var latest int
+	err := tx.Model(&models.BookRevision{}).Where("book_id = ?", after.ID).
+		Select("COALESCE(MAX(revision), 0)").Scan(&latest).Error
+	if err != nil {
+		return err
+	}
+
+	var revisions []models.BookRevision
+	if latest == 0 {
+		latest++
+		revisions = append(revisions, models.BookRevision{
+			BookID:   after.ID,
+			Revision: latest,
+			Source:   models.RevisionInitial,
+			Summary:  before.Summary,
+			Notes:    before.Notes,
+		})
+	}
+	revisions = append(revisions, models.BookRevision{
+		BookID:       after.ID,
+		Revision:     latest + 1,
+		Source:       source,
+		RestoredFrom: restoredFrom,
+		Summary:      after.Summary,
+		Notes:        after.Notes,
+	})
+	return tx.Create(&revisions).Error
+}
+
+// findBookRevision kitabın verilen numaralı revizyonunu döner
+func findBookRevision(bookID uint, revision int) (models.BookRevision, error) {
+	var result models.BookRevision
+	err := config.DB.Where("book_id = ? AND revision = ?", bookID, revision).First(&result).Error
+	return result, err
+}
+
+// parseRevision revizyon numarasını ayrıştırır; numaralar 1'den başlar
+func parseRevision(value string) (int, error) {
+	revision, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+	if revision < 1 {
+		return 0, errors.New("revizyon numarası 1 veya daha büyük olmalıdır")
+	}
+	return revision, nil
+}
+
+// GetBookRevisions godoc
+// @Summary      Kitap revizyonları
+// @Description  Kitabın özet ve notlarının kayıtlı revizyonlarını en yeniden başlayarak listeler. Özet veya notlar hiç değişmediyse liste boştur.
+// @Tags         books
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Kitap ID"
+// @Success      200  {array}   models.BookRevision
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/{id}/revisions [get]
+func GetBookRevisions(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	bookID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kitap ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	book, err := findUserBook(config.DB, userID, bookID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	revisions := []models.BookRevision{}
+	if err := config.DB.Where("book_id = ?", book.ID).Order("revision DESC").Find(&revisions).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Revizyonlar getirilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Revizyonlar başarıyla getirildi",
+		"data":    revisions,
+	})
+}
+
+// GetBookRevisionDiff godoc
+// @Summary      Revizyon farkı
+// @Description  İki revizyon arasındaki özet ve not farkını satır satır döner. to verilmezse en son revizyonla karşılaştırılır.
+// @Tags         books
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int  true   "Kitap ID"
+// @Param        from  query     int  true   "Eski revizyon numarası"
+// @Param        to    query     int  false  "Yeni revizyon numarası (varsayılan en son revizyon)"
+// @Success      200   {object}  models.BookRevisionDiff
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      404   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/{id}/revisions/diff [get]
+func GetBookRevisionDiff(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	bookID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kitap ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	from, err := parseRevision(c.Query("from"))
+	var to int
+	if err == nil && c.Query("to") != "" {
+		to, err = parseRevision(c.Query("to"))
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz revizyon",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	book, err := findUserBook(config.DB, userID, bookID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if to == 0 {
+		err = config.DB.Model(&models.BookRevision{}).Where("book_id = ?", book.ID).
+			Select("COALESCE(MAX(revision), 0)").Scan(&to).Error
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"status":  "error",
+				"message": "Revizyonlar karşılaştırılamadı",
+				"error":   err.Error(),
+			})
+			return
+		}
+	}
+
+	older, err := findBookRevision(book.ID, from)
+	var newer models.BookRevision
+	if err == nil {
+		newer, err = findBookRevision(book.ID, to)
+	}
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Revizyon bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Revizyonlar karşılaştırıldı",
+		"data": models.BookRevisionDiff{
+			From:    older.Revision,
+			To:      newer.Revision,
+			Summary: utils.DiffLines(older.Summary, newer.Summary),
+			Notes:   utils.DiffLines(older.Notes, newer.Notes),
+		},
+	})
+}
+
+// RestoreBookRevision godoc
+// @Summary      Revizyonu geri yükleme
+// @Description  Kitabın özet ve notlarını verilen revizyondaki haline döndürür. Geri yükleme yeni bir revizyon olarak kaydedilir, böylece geri alınabilir.
+// @Tags         books
+// @Accept       json
+// @Produce      json
+// @Param        id        path      int     true   "Kitap ID"
+// @Param        rev       path      int     true   "Revizyon numarası"
+// @Param        If-Match  header    string  false  "Kitabın güncel ETag değeri"
+// @Success      200       {object}  models.BookResponse
+// @Failure      400       {object}  map[string]interface{}
+// @Failure      401       {object}  map[string]interface{}
+// @Failure      404       {object}  map[string]interface{}
+// @Failure      412       {object}  map[string]interface{}
+// @Failure      500       {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/{id}/revisions/{rev}/restore [post]
+func RestoreBookRevision(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	bookID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kitap ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	number, err := parseRevision(c.Param("rev"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz revizyon",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	book, err := findUserBook(config.DB, userID, bookID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if !checkIfMatch(c, book) {
+		return
+	}
+
+	revision, err := findBookRevision(book.ID, number)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Revizyon bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Metinler zaten revizyondaki gibiyse kitap değiştirilmez
+	if book.Summary != revision.Summary || book.Notes != revision.Notes {
+		before := book
+		book.Summary, book.Notes = revision.Summary, revision.Notes
+		err = config.DB.Transaction(func(tx *gorm.DB) error {
+			if err := bumpBookVersion(tx, &book); err != nil {
+				return err
+			}
+			if err := tx.Model(&book).Select("summary", "notes").Updates(&book).Error; err != nil {
+				return err
+			}
+			return recordBookRevision(tx, before, book, models.RevisionRestore, revision.Revision)
+		})
+		if err != nil {
+			respondBookSaveError(c, err, "Revizyon geri yüklenemedi")
+			return
+		}
+	}
+
+	respondBookDetail(c, userID, book, "Revizyon başarıyla geri yüklendi")
+}
@@ -0,0 +1,356 @@
+package controllers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+
+	"go-api/config"
+	"go-api/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// newShareToken tahmin edilemeyen 32 karakterlik bir paylaşım anahtarı üretir
+func newShareToken() (*string, error) {
+	data := make([]byte, 16)
+	if _, err := rand.Read(data); err != nil {
+		return nil, err
+	}
+	token := hex.EncodeToString(data)
+	return &token, nil
+}
+
+// applyBookVisibility görünürlüğü normalize eder: boş değer private sayılır.
+// Private kitabın paylaşım anahtarı silinir, böylece eski bağlantılar geçersiz
+// olur; paylaşılan kitabın anahtarı yoksa oluşturulur.
+func applyBookVisibility(book *models.Book) error {
+	if book.Visibility == "" {
+		book.Visibility = models.VisibilityPrivate
+	}
+	if book.Visibility == models.VisibilityPrivate {
+		book.ShareToken = nil
+		return nil
+	}
+	if book.ShareToken != nil {
+		return nil
+	}
+
+	token, err := newShareToken()
+	if err != nil {
+		return err
+	}
+	book.ShareToken = token
+	return nil
+}
+
+// bookShareURL paylaşılan kitabın bağlantısının yolunu döner; private kitapta boştur
+func bookShareURL(book models.Book) string {
+	if book.Visibility == models.VisibilityPrivate || book.ShareToken == nil {
+		return ""
+	}
+	return "/api/shared/books/" + *book.ShareToken
+}
+
+// newPublicUser kullanıcının herkese açık bilgilerini döner
+func newPublicUser(user models.User) models.PublicUser {
+	return models.PublicUser{FirstName: user.FirstName, LastName: user.LastName, Username: user.Username}
+}
+
+// canViewProfile private hesabın public kitaplarını yalnızca hesabın kendisinin
+// ve onaylı takipçilerinin görebildiğini denetler; diğer hesaplar herkese açıktır
+func canViewProfile(c *gin.Context, user models.User) bool {
+	if !user.PrivateAccount {
+		return true
+	}
+	viewerID, exists := c.Get("user_id")
+	if !exists {
+		return false
+	}
+	if viewerID == user.ID {
+		return true
+	}
+
+	var count int64
+	err := config.DB.Model(&models.Follow{}).
+		Where("follower_id = ? AND followee_id = ? AND status = ?", viewerID, user.ID, models.FollowAccepted).
+		Count(&count).Error
+	return err == nil && count > 0
+}
+
+// respondPrivateProfile gizli profile erişimi reddeder
+func respondPrivateProfile(c *gin.Context) {
+	c.JSON(http.StatusForbidden, gin.H{
+		"status":  "error",
+		"message": "Bu hesap gizli",
+		"error":   "kitapları yalnızca onaylı takipçiler görebilir",
+	})
+}
+
+// newPublicBookResponse kitabın herkese açık görünümünü üretir.
+// book.Authors ve book.Series yüklenmiş olmalıdır.
+func newPublicBookResponse(book models.Book, user models.User) models.PublicBookResponse {
+	response := models.PublicBookResponse{
+		ID:           book.ID,
+		Title:        book.Title,
+		Author:       book.Author,
+		Summary:      book.Summary,
+		ReadDate:     book.ReadDate,
+		Rating:       book.Rating,
+		Tags:         book.Tags,
+		ISBN13:       book.ISBN13,
+		PageCount:    book.PageCount,
+		PublishYear:  book.PublishYear,
+		LikeCount:    book.LikeCount,
+		CommentCount: book.CommentCount,
+		User:         newPublicUser(user),
+	}
+	if book.ShareNotes {
+		response.Notes = book.Notes
+	}
+	response.CoverURL, response.ThumbnailURL = bookCoverURLs(book)
+	if book.Series != nil {
+		response.Series = &models.SeriesSummary{
+			ID:       book.Series.ID,
+			Name:     book.Series.Name,
+			Position: book.SeriesPosition,
+		}
+	}
+	response.Authors = make([]models.AuthorSummary, len(book.Authors))
+	for i, author := range book.Authors {
+		response.Authors[i] = models.AuthorSummary{ID: author.ID, Name: author.Name}
+	}
+	if response.Tags == nil {
+		response.Tags = []string{}
+	}
+	return response
+}
+
+// GetPublicProfileBooks godoc
+// @Summary      Herkese açık profil
+// @Description  Kullanıcının public kitaplarını yeniden eskiye listeler. Kimlik doğrulama gerektirmez; private hesapların kitaplarını yalnızca hesabın kendisi ve onaylı takipçileri Bearer token ile görebilir.
+// @Tags         public
+// @Accept       json
+// @Produce      json
+// @Param        username  path      string  true  "Kullanıcı adı"
+// @Success      200       {object}  models.PublicProfileResponse
+// @Failure      403       {object}  map[string]interface{}
+// @Failure      404       {object}  map[string]interface{}
+// @Failure      500       {object}  map[string]interface{}
+// @Router       /api/users/{username}/books [get]
+func GetPublicProfileBooks(c *gin.Context) {
+	var user models.User
+	if err := config.DB.Where("username = ?", c.Param("username")).First(&user).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kullanıcı bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if !canViewProfile(c, user) {
+		respondPrivateProfile(c)
+		return
+	}
+
+	var books []models.Book
+	err := config.DB.Preload("Authors").Preload("Series").
+		Where("user_id = ? AND visibility = ?", user.ID, models.VisibilityPublic).
+		Order("read_date DESC, id DESC").
+		Find(&books).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kitaplar alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	response := models.PublicProfileResponse{
+		User:  newPublicUser(user),
+		Books: make([]models.PublicBookResponse, 0, len(books)),
+	}
+	for _, book := range books {
+		response.Books = append(response.Books, newPublicBookResponse(book, user))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Profil başarıyla getirildi",
+		"data":    response,
+	})
+}
+
+// GetPublicBook godoc
+// @Summary      Herkese açık kitap
+// @Description  Kullanıcının public bir kitabını getirir. Kimlik doğrulama gerektirmez; private hesapların kitaplarını yalnızca hesabın kendisi ve onaylı takipçileri görebilir.
+// @Tags         public
+// @Accept       json
+// @Produce      json
+// @Param        username  path      string  true  "Kullanıcı adı"
+// @Param        id        path      int     true  "Kitap ID"
+// @Success      200       {object}  models.PublicBookResponse
+// @Failure      400       {object}  map[string]interface{}
+// @Failure      403       {object}  map[string]interface{}
+// @Failure      404       {object}  map[string]interface{}
+// @Router       /api/users/{username}/books/{id} [get]
+func GetPublicBook(c *gin.Context) {
+	bookID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kitap ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var user models.User
+	if err := config.DB.Where("username = ?", c.Param("username")).First(&user).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kullanıcı bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if !canViewProfile(c, user) {
+		respondPrivateProfile(c)
+		return
+	}
+
+	var book models.Book
+	err = config.DB.Preload("Authors").Preload("Series").
+		Where("id = ? AND user_id = ? AND visibility = ?", bookID, user.ID, models.VisibilityPublic).
+		First(&book).Error
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Kitap başarıyla getirildi",
+		"data":    newPublicBookResponse(book, user),
+	})
+}
+
+// GetSharedBook godoc
+// @Summary      Paylaşılan kitap
+// @Description  Paylaşım bağlantısıyla unlisted veya public bir kitabı getirir. Kimlik doğrulama gerektirmez.
+// @Tags         public
+// @Accept       json
+// @Produce      json
+// @Param        token  path      string  true  "Paylaşım anahtarı"
+// @Success      200    {object}  models.PublicBookResponse
+// @Failure      404    {object}  map[string]interface{}
+// @Failure      500    {object}  map[string]interface{}
+// @Router       /api/shared/books/{token} [get]
+func GetSharedBook(c *gin.Context) {
+	var book models.Book
+	err := config.DB.Preload("Authors").Preload("Series").
+		Where("share_token = ? AND visibility <> ?", c.Param("token"), models.VisibilityPrivate).
+		First(&book).Error
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var user models.User
+	if err := config.DB.First(&user, book.UserID).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kullanıcı bilgileri alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Kitap başarıyla getirildi",
+		"data":    newPublicBookResponse(book, user),
+	})
+}
+
+// RotateBookShareLink godoc
+// @Summary      Paylaşım bağlantısını yenileme
+// @Description  Kitabın paylaşım anahtarını yeniler; eski bağlantı geçersiz olur. Private kitaplar için kullanılamaz.
+// @Tags         books
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Kitap ID"
+// @Success      200  {object}  models.BookResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/{id}/share [post]
+func RotateBookShareLink(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	bookID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kitap ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	book, err := findUserBook(config.DB, userID, bookID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if book.Visibility == models.VisibilityPrivate {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Private kitap paylaşılamaz",
+			"error":   "önce görünürlüğü unlisted veya public yapın",
+		})
+		return
+	}
+
+	book.ShareToken = nil
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := applyBookVisibility(&book); err != nil {
+			return err
+		}
+		if err := bumpBookVersion(tx, &book); err != nil {
+			return err
+		}
+		return tx.Model(&book).Select("share_token").Updates(&book).Error
+	})
+	if err != nil {
+		respondBookSaveError(c, err, "Paylaşım bağlantısı yenilenemedi")
+		return
+	}
+
+	respondBookDetail(c, userID, book, "Paylaşım bağlantısı başarıyla yenilendi")
+}
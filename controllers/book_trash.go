@@ -0,0 +1,202 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"go-api/config"
+	"go-api/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// GetTrashedBooks godoc
+// @Summary      Çöp kutusu
+// @Description  Kullanıcının silinmiş kitaplarını ve kalıcı silinme zamanlarını listeler
+// @Tags         books
+// @Accept       json
+// @Produce      json
+// @Success      200  {array}   models.TrashedBookResponse
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/trash [get]
+func GetTrashedBooks(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	var books []models.Book
+	err := config.DB.Unscoped().
+		Where("user_id = ? AND deleted_at IS NOT NULL", userID).
+		Order("deleted_at DESC").
+		Find(&books).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Silinmiş kitaplar alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Response hazırla
+	retention := config.TrashRetention()
+	response := []models.TrashedBookResponse{}
+	for _, book := range books {
+		response = append(response, models.TrashedBookResponse{
+			ID:        book.ID,
+			Title:     book.Title,
+			Author:    book.Author,
+			Rating:    book.Rating,
+			DeletedAt: book.DeletedAt.Time,
+			PurgeAt:   book.DeletedAt.Time.Add(retention),
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Silinmiş kitaplar başarıyla getirildi",
+		"data":    response,
+	})
+}
+
+// RestoreBook godoc
+// @Summary      Kitap geri yükleme
+// @Description  Çöp kutusundaki kitabı geri yükler
+// @Tags         books
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Kitap ID"
+// @Success      200  {object}  models.BookResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/{id}/restore [post]
+func RestoreBook(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	bookID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kitap ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var book models.Book
+	err = config.DB.Unscoped().
+		Where("id = ? AND user_id = ? AND deleted_at IS NOT NULL", bookID, userID).
+		First(&book).Error
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Çöp kutusunda kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		// Silindikten sonra aynı ISBN ile eklenen bir kitap varsa geri yüklenmez
+		if err := checkBookISBN(tx, &book); err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(&book).Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		book.DeletedAt = gorm.DeletedAt{}
+		if err := bumpBookVersion(tx, &book); err != nil {
+			return err
+		}
+		if err := loadBookAuthors(tx, &book); err != nil {
+			return err
+		}
+		if err := loadBookSeries(tx, &book); err != nil {
+			return err
+		}
+		return syncBookFromReads(tx, &book)
+	})
+	if err != nil {
+		respondBookSaveError(c, err, "Kitap geri yüklenemedi")
+		return
+	}
+
+	// Kullanıcı bilgilerini al
+	var user models.User
+	if err := config.DB.First(&user, userID).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kullanıcı bilgileri alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Response hazırla
+	response := newBookResponse(book, user)
+
+	c.Header("ETag", bookETag(book))
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Kitap başarıyla geri yüklendi",
+		"data":    response,
+	})
+}
+
+// deleteBookPermanently kitabı (çöp kutusunda olsa bile) bağlı kayıtlarıyla
+// birlikte kalıcı olarak siler
+func deleteBookPermanently(c *gin.Context, userID interface{}, bookID uint64) {
+	var book models.Book
+	if err := config.DB.Unscoped().Where("id = ? AND user_id = ?", bookID, userID).First(&book).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if !checkIfMatch(c, book) {
+		return
+	}
+
+	var coverKeys []string
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := bumpBookVersion(tx.Unscoped(), &book); err != nil {
+			return err
+		}
+		keys, err := config.DeleteBooksPermanently(tx, []uint{book.ID})
+		coverKeys = keys
+		return err
+	})
+	if err != nil {
+		respondBookSaveError(c, err, "Kitap silinemedi")
+		return
+	}
+	config.DeleteCoverFiles(coverKeys)
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Kitap kalıcı olarak silindi",
+	})
+}
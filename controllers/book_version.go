@@ -0,0 +1,125 @@
+package controllers
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go-api/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// errVersionConflict kitap okunduktan sonra başka bir istekle değiştirildiğinde döner
+var errVersionConflict = errors.New("kitap başka bir istek tarafından değiştirildi")
+
+// bookETag kitabın ID, sürüm ve beğeni/yorum sayılarından strong ETag üretir.
+// Sayılar sürümü artırmadan değiştiğinden yanıtın güncelliği için ETag'e
+// eklenir; If-Match ise yalnızca ID ve sürümü karşılaştırır.
+func bookETag(book models.Book) string {
+	return fmt.Sprintf("\"%d-%d-%d-%d\"", book.ID, book.Version, book.LikeCount, book.CommentCount)
+}
+
+// bookVersionMatches ETag'in kitabın ID ve sürümüne ait olup olmadığını
+// döner; beğeni ve yorum sayıları yok sayılır
+func bookVersionMatches(etag string, book models.Book) bool {
+	etag = strings.Trim(strings.TrimPrefix(etag, "W/"), "\"")
+	parts := strings.Split(etag, "-")
+	return len(parts) >= 2 && parts[0] == fmt.Sprint(book.ID) && parts[1] == fmt.Sprint(book.Version)
+}
+
+// bookListETag liste içeriğindeki kitapların ID ve sürümlerinden weak ETag üretir
+func bookListETag(books []models.Book) string {
+	hash := sha1.New()
+	for _, book := range books {
+		fmt.Fprintf(hash, "%d-%d;", book.ID, book.Version)
+	}
+	return "W/\"" + hex.EncodeToString(hash.Sum(nil)) + "\""
+}
+
+// etagMatches başlıktaki ETag listesinde verilen etiket var mı kontrol eder.
+// "*" her etiketle eşleşir; karşılaştırma weak öneki yok sayılarak yapılır.
+func etagMatches(header, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// checkIfMatch If-Match başlığı gönderildiyse kitabın sürümüyle karşılaştırır.
+// Eşleşmezse 412 döner ve false verir.
+func checkIfMatch(c *gin.Context, book models.Book) bool {
+	header := c.GetHeader("If-Match")
+	if header == "" {
+		return true
+	}
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || bookVersionMatches(candidate, book) {
+			return true
+		}
+	}
+
+	c.Header("ETag", bookETag(book))
+	c.JSON(http.StatusPreconditionFailed, gin.H{
+		"status":  "error",
+		"message": "Kitap başka bir istek tarafından değiştirildi",
+		"error":   "If-Match başlığı güncel sürümle eşleşmiyor",
+	})
+	return false
+}
+
+// checkIfNoneMatch If-None-Match başlığı ETag ile eşleşiyorsa 304 döner ve true verir
+func checkIfNoneMatch(c *gin.Context, etag string) bool {
+	c.Header("ETag", etag)
+	header := c.GetHeader("If-None-Match")
+	if header == "" || !etagMatches(header, etag) {
+		return false
+	}
+
+	c.Status(http.StatusNotModified)
+	return true
+}
+
+// bumpBookVersion kitabın sürümünü bir artırır. Güncelleme yalnızca veritabanındaki
+// sürüm hâlâ book.Version ise yapılır; aksi halde errVersionConflict döner.
+func bumpBookVersion(tx *gorm.DB, book *models.Book) error {
+	result := tx.Model(&models.Book{}).
+		Where("id = ? AND version = ?", book.ID, book.Version).
+		UpdateColumn("version", gorm.Expr("version + 1"))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errVersionConflict
+	}
+	book.Version++
+	return nil
+}
+
+// respondBookSaveError kitap kaydetme hatasını uygun durum koduyla döner
+func respondBookSaveError(c *gin.Context, err error, message string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, errVersionConflict):
+		status = http.StatusPreconditionFailed
+	case errors.Is(err, errInvalidISBN), errors.Is(err, errInvalidSeries):
+		status = http.StatusBadRequest
+	case errors.Is(err, errDuplicateISBN), isDuplicateISBNError(err):
+		status = http.StatusConflict
+	}
+
+	c.JSON(status, gin.H{
+		"status":  "error",
+		"message": message,
+		"error":   err.Error(),
+	})
+}
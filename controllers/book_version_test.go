@@ -0,0 +1,35 @@
+package controllers
+
+import (
+	"testing"
+
+	"go-api/models"
+)
+
+func TestBookVersionMatches(t *testing.T) {
+	book := models.Book{ID: 12, Version: 3, LikeCount: 4, CommentCount: 1}
+	liked := book
+	liked.LikeCount++
+
+	tests := []struct {
+		etag string
+		want bool
+	}{
+		{bookETag(book), true},
+		{bookETag(liked), true},
+		{"W/" + bookETag(book), true},
+		{`"12-3"`, true},
+		{`"12-2-4-1"`, false},
+		{`"13-3-4-1"`, false},
+		{`"12"`, false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := bookVersionMatches(tt.etag, book); got != tt.want {
+			t.Errorf("bookVersionMatches(%q) = %v, want %v", tt.etag, got, tt.want)
+		}
+	}
+	if bookETag(book) == bookETag(liked) {
+		t.Error("beğeni sayısı değiştiğinde ETag değişmedi")
+	}
+}
@@ -0,0 +1,936 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go-api/config"
+	"go-api/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// clubRoleRank rollerin yetki sırası; yüksek rol düşük rolün tüm yetkilerine sahiptir
+var clubRoleRank = map[string]int{
+	models.ClubMember:    0,
+	models.ClubModerator: 1,
+	models.ClubOwner:     2,
+}
+
+// clubMembership ClubMemberMiddleware'in doğruladığı üyeliği döner
+func clubMembership(c *gin.Context) models.ClubMembership {
+	return c.MustGet("club_membership").(models.ClubMembership)
+}
+
+// requireClubRole üyenin en az verilen role sahip olduğunu doğrular. Değilse
+// yanıtı yazar ve false döner.
+func requireClubRole(c *gin.Context, membership models.ClubMembership, role string) bool {
+	if clubRoleRank[membership.Role] >= clubRoleRank[role] {
+		return true
+	}
+	c.JSON(http.StatusForbidden, gin.H{
+		"status":  "error",
+		"message": "Bu işlem için yetkiniz yok",
+		"error":   "kulüpte en az " + role + " rolü gerekir",
+	})
+	return false
+}
+
+// clubResponses üyelikleri üye sayısı ve güncel kitapla birlikte kulüp yanıtlarına çevirir.
+// membership.Club yüklenmiş olmalıdır.
+func clubResponses(memberships []models.ClubMembership) ([]models.ClubResponse, error) {
+	clubIDs := make([]uint, len(memberships))
+	for i, membership := range memberships {
+		clubIDs[i] = membership.ClubID
+	}
+
+	var counts []struct {
+		ClubID uint
+		Count  int
+	}
+	err := config.DB.Model(&models.ClubMembership{}).
+		Select("club_id, COUNT(*) AS count").
+		Where("club_id IN ?", clubIDs).
+		Group("club_id").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	memberCounts := make(map[uint]int, len(counts))
+	for _, count := range counts {
+		memberCounts[count.ClubID] = count.Count
+	}
+
+	var current []models.ClubBook
+	err = config.DB.Where("club_id IN ? AND status = ?", clubIDs, models.ClubBookCurrent).Find(&current).Error
+	if err != nil {
+		return nil, err
+	}
+	currentReads := make(map[uint]models.ClubBook, len(current))
+	for _, book := range current {
+		currentReads[book.ClubID] = book
+	}
+
+	responses := make([]models.ClubResponse, 0, len(memberships))
+	for _, membership := range memberships {
+		response := models.ClubResponse{
+			Club:        *membership.Club,
+			Role:        membership.Role,
+			MemberCount: memberCounts[membership.ClubID],
+		}
+		if book, ok := currentReads[membership.ClubID]; ok {
+			response.CurrentRead = &book
+		}
+		responses = append(responses, response)
+	}
+	return responses, nil
+}
+
+// GetClubs godoc
+// @Summary      Kulüpleri listeleme
+// @Description  Kullanıcının üye olduğu kulüpleri rolü, üye sayısı ve güncel kitabıyla listeler
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Success      200  {array}   models.ClubResponse
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs [get]
+func GetClubs(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	var memberships []models.ClubMembership
+	err := config.DB.Preload("Club").Where("user_id = ?", userID).Order("created_at DESC, id DESC").Find(&memberships).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kulüpler alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	response, err := clubResponses(memberships)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kulüpler alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Kulüpler başarıyla getirildi",
+		"data":    response,
+	})
+}
+
+// CreateClub godoc
+// @Summary      Kulüp oluşturma
+// @Description  Yeni bir kulüp oluşturur; oluşturan kullanıcı kulübün sahibi olur
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        club  body      models.ClubRequest  true  "Kulüp bilgileri"
+// @Success      201   {object}  models.ClubResponse
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs [post]
+func CreateClub(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	var request models.ClubRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	club := models.Club{Name: strings.TrimSpace(request.Name), Description: request.Description}
+	membership := models.ClubMembership{UserID: userID.(uint), Role: models.ClubOwner}
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&club).Error; err != nil {
+			return err
+		}
+		membership.ClubID = club.ID
+		return tx.Create(&membership).Error
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kulüp oluşturulamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"status":  "success",
+		"message": "Kulüp başarıyla oluşturuldu",
+		"data":    models.ClubResponse{Club: club, Role: membership.Role, MemberCount: 1},
+	})
+}
+
+// GetClub godoc
+// @Summary      Kulüp detayı
+// @Description  Kulübü güncel kitabı ve sıradaki kitaplarıyla getirir
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Kulüp ID"
+// @Success      200  {object}  models.ClubResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/{id} [get]
+func GetClub(c *gin.Context) {
+	membership := clubMembership(c)
+
+	responses, err := clubResponses([]models.ClubMembership{membership})
+	if err == nil {
+		err = config.DB.Where("club_id = ? AND status = ?", membership.ClubID, models.ClubBookUpcoming).
+			Order("position, id").
+			Find(&responses[0].Upcoming).Error
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kulüp alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Kulüp başarıyla getirildi",
+		"data":    responses[0],
+	})
+}
+
+// UpdateClub godoc
+// @Summary      Kulüp güncelleme
+// @Description  Kulübün adını ve açıklamasını günceller; moderator veya owner rolü gerekir
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int                 true  "Kulüp ID"
+// @Param        club  body      models.ClubRequest  true  "Kulüp bilgileri"
+// @Success      200   {object}  models.Club
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      403   {object}  map[string]interface{}
+// @Failure      404   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/{id} [put]
+func UpdateClub(c *gin.Context) {
+	membership := clubMembership(c)
+	if !requireClubRole(c, membership, models.ClubModerator) {
+		return
+	}
+
+	var request models.ClubRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	club := *membership.Club
+	club.Name = strings.TrimSpace(request.Name)
+	club.Description = request.Description
+	if err := config.DB.Save(&club).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kulüp güncellenemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Kulüp başarıyla güncellendi",
+		"data":    club,
+	})
+}
+
+// DeleteClub godoc
+// @Summary      Kulüp silme
+// @Description  Kulübü üyelikleri, davetleri, okuma listesi ve tartışmalarıyla birlikte siler; owner rolü gerekir
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Kulüp ID"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/{id} [delete]
+func DeleteClub(c *gin.Context) {
+	membership := clubMembership(c)
+	if !requireClubRole(c, membership, models.ClubOwner) {
+		return
+	}
+
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		children := []interface{}{&models.ClubPost{}, &models.ClubBook{}, &models.ClubInvitation{}, &models.ClubMembership{}}
+		for _, child := range children {
+			if err := tx.Where("club_id = ?", membership.ClubID).Delete(child).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Delete(&models.Club{}, membership.ClubID).Error
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kulüp silinemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Kulüp başarıyla silindi",
+	})
+}
+
+// GetClubMembers godoc
+// @Summary      Kulüp üyeleri
+// @Description  Kulübün üyelerini rolleriyle listeler
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Kulüp ID"
+// @Success      200  {array}   models.ClubMemberResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/{id}/members [get]
+func GetClubMembers(c *gin.Context) {
+	membership := clubMembership(c)
+
+	var members []models.ClubMembership
+	err := config.DB.Preload("User").Where("club_id = ?", membership.ClubID).Order("created_at, id").Find(&members).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Üyeler alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	response := make([]models.ClubMemberResponse, 0, len(members))
+	for _, member := range members {
+		if member.User == nil {
+			continue
+		}
+		response = append(response, models.ClubMemberResponse{
+			UserID:   member.UserID,
+			Role:     member.Role,
+			User:     newPublicUser(*member.User),
+			JoinedAt: member.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Üyeler başarıyla getirildi",
+		"data":    response,
+	})
+}
+
+// findClubMember yol parametresindeki kullanıcının kulüp üyeliğini bulur.
+// Hata durumunda yanıtı yazar ve false döner.
+func findClubMember(c *gin.Context, clubID uint) (models.ClubMembership, bool) {
+	var member models.ClubMembership
+
+	memberID, err := strconv.ParseUint(c.Param("userId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kullanıcı ID",
+			"error":   err.Error(),
+		})
+		return member, false
+	}
+
+	if err := config.DB.Where("club_id = ? AND user_id = ?", clubID, memberID).First(&member).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Üye bulunamadı",
+			"error":   err.Error(),
+		})
+		return member, false
+	}
+	return member, true
+}
+
+// UpdateClubMember godoc
+// @Summary      Üye rolü değiştirme
+// @Description  Üyenin rolünü değiştirir; owner rolü gerekir. owner verilirse sahiplik devredilir ve eski sahip moderator olur.
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int                     true  "Kulüp ID"
+// @Param        userId  path      int                     true  "Kullanıcı ID"
+// @Param        role    body      models.ClubRoleRequest  true  "Yeni rol"
+// @Success      200     {object}  map[string]interface{}
+// @Failure      400     {object}  map[string]interface{}
+// @Failure      401     {object}  map[string]interface{}
+// @Failure      403     {object}  map[string]interface{}
+// @Failure      404     {object}  map[string]interface{}
+// @Failure      500     {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/{id}/members/{userId} [put]
+func UpdateClubMember(c *gin.Context) {
+	membership := clubMembership(c)
+	if !requireClubRole(c, membership, models.ClubOwner) {
+		return
+	}
+
+	member, ok := findClubMember(c, membership.ClubID)
+	if !ok {
+		return
+	}
+	if member.ID == membership.ID {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   "kendi rolünüzü değiştiremezsiniz; sahipliği başka bir üyeye devredin",
+		})
+		return
+	}
+
+	var request models.ClubRoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		if request.Role == models.ClubOwner {
+			err := tx.Model(&membership).Update("role", models.ClubModerator).Error
+			if err != nil {
+				return err
+			}
+		}
+		return tx.Model(&member).Update("role", request.Role).Error
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Rol değiştirilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Rol başarıyla değiştirildi",
+		"data":    gin.H{"user_id": member.UserID, "role": request.Role},
+	})
+}
+
+// RemoveClubMember godoc
+// @Summary      Üye çıkarma veya kulüpten ayrılma
+// @Description  Kendi kullanıcı ID'siyle çağrıldığında kulüpten ayrılır; owner ayrılmadan önce sahipliği devretmelidir. Diğer üyeleri yalnızca daha yüksek roldeki moderator veya owner çıkarabilir.
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int  true  "Kulüp ID"
+// @Param        userId  path      int  true  "Kullanıcı ID"
+// @Success      200     {object}  map[string]interface{}
+// @Failure      400     {object}  map[string]interface{}
+// @Failure      401     {object}  map[string]interface{}
+// @Failure      403     {object}  map[string]interface{}
+// @Failure      404     {object}  map[string]interface{}
+// @Failure      500     {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/{id}/members/{userId} [delete]
+func RemoveClubMember(c *gin.Context) {
+	membership := clubMembership(c)
+
+	member, ok := findClubMember(c, membership.ClubID)
+	if !ok {
+		return
+	}
+
+	if member.ID == membership.ID {
+		if membership.Role == models.ClubOwner {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"status":  "error",
+				"message": "Geçersiz istek",
+				"error":   "kulüpten ayrılmadan önce sahipliği devredin veya kulübü silin",
+			})
+			return
+		}
+	} else {
+		if !requireClubRole(c, membership, models.ClubModerator) {
+			return
+		}
+		if clubRoleRank[member.Role] >= clubRoleRank[membership.Role] {
+			c.JSON(http.StatusForbidden, gin.H{
+				"status":  "error",
+				"message": "Bu işlem için yetkiniz yok",
+				"error":   "yalnızca daha düşük roldeki üyeler çıkarılabilir",
+			})
+			return
+		}
+	}
+
+	if err := config.DB.Delete(&member).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Üye çıkarılamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	message := "Üye kulüpten çıkarıldı"
+	if member.ID == membership.ID {
+		message = "Kulüpten ayrıldınız"
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": message,
+	})
+}
+
+// newClubInvitationResponse daveti yanıta çevirir; yüklenmiş ilişkiler doldurulur
+func newClubInvitationResponse(invitation models.ClubInvitation) models.ClubInvitationResponse {
+	response := models.ClubInvitationResponse{
+		ID:        invitation.ID,
+		Club:      invitation.Club,
+		CreatedAt: invitation.CreatedAt,
+	}
+	if invitation.User != nil {
+		user := newPublicUser(*invitation.User)
+		response.User = &user
+	}
+	if invitation.InvitedBy != nil {
+		response.InvitedBy = newPublicUser(*invitation.InvitedBy)
+	}
+	return response
+}
+
+// GetClubInvitations godoc
+// @Summary      Kulübün davetleri
+// @Description  Kulübün bekleyen davetlerini listeler; moderator veya owner rolü gerekir
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Kulüp ID"
+// @Success      200  {array}   models.ClubInvitationResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/{id}/invitations [get]
+func GetClubInvitations(c *gin.Context) {
+	membership := clubMembership(c)
+	if !requireClubRole(c, membership, models.ClubModerator) {
+		return
+	}
+
+	var invitations []models.ClubInvitation
+	err := config.DB.Preload("User").Preload("InvitedBy").
+		Where("club_id = ?", membership.ClubID).
+		Order("created_at DESC, id DESC").
+		Find(&invitations).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Davetler alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	response := make([]models.ClubInvitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		response = append(response, newClubInvitationResponse(invitation))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Davetler başarıyla getirildi",
+		"data":    response,
+	})
+}
+
+// CreateClubInvitation godoc
+// @Summary      Kulübe davet etme
+// @Description  Kullanıcıyı kulübe davet eder; moderator veya owner rolü gerekir. Kullanıcı zaten davetliyse mevcut davet döner.
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        id          path      int                           true  "Kulüp ID"
+// @Param        invitation  body      models.ClubInvitationRequest  true  "Davet edilecek kullanıcı"
+// @Success      200         {object}  models.ClubInvitationResponse
+// @Success      201         {object}  models.ClubInvitationResponse
+// @Failure      400         {object}  map[string]interface{}
+// @Failure      401         {object}  map[string]interface{}
+// @Failure      403         {object}  map[string]interface{}
+// @Failure      404         {object}  map[string]interface{}
+// @Failure      409         {object}  map[string]interface{}
+// @Failure      500         {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/{id}/invitations [post]
+func CreateClubInvitation(c *gin.Context) {
+	membership := clubMembership(c)
+	if !requireClubRole(c, membership, models.ClubModerator) {
+		return
+	}
+
+	var request models.ClubInvitationRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var user models.User
+	if err := config.DB.Where("username = ?", request.Username).First(&user).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kullanıcı bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var count int64
+	if err := config.DB.Model(&models.ClubMembership{}).Where("club_id = ? AND user_id = ?", membership.ClubID, user.ID).Count(&count).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Davet gönderilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if count > 0 {
+		c.JSON(http.StatusConflict, gin.H{
+			"status":  "error",
+			"message": "Kullanıcı zaten kulüp üyesi",
+			"error":   "kullanıcı zaten kulüp üyesi",
+		})
+		return
+	}
+
+	invitation := models.ClubInvitation{ClubID: membership.ClubID, UserID: user.ID}
+	status := http.StatusOK
+	err := config.DB.Preload("InvitedBy").Where(&invitation).First(&invitation).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		invitation.InvitedByID = membership.UserID
+		if err = config.DB.Create(&invitation).Error; err == nil {
+			err = config.DB.First(&invitation.InvitedBy, invitation.InvitedByID).Error
+		}
+		status = http.StatusCreated
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Davet gönderilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	invitation.User = &user
+	c.JSON(status, gin.H{
+		"status":  "success",
+		"message": "Davet gönderildi",
+		"data":    newClubInvitationResponse(invitation),
+	})
+}
+
+// CancelClubInvitation godoc
+// @Summary      Daveti iptal etme
+// @Description  Kulübün bekleyen bir davetini iptal eder; moderator veya owner rolü gerekir
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        id            path      int  true  "Kulüp ID"
+// @Param        invitationId  path      int  true  "Davet ID"
+// @Success      200           {object}  map[string]interface{}
+// @Failure      400           {object}  map[string]interface{}
+// @Failure      401           {object}  map[string]interface{}
+// @Failure      403           {object}  map[string]interface{}
+// @Failure      404           {object}  map[string]interface{}
+// @Failure      500           {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/{id}/invitations/{invitationId} [delete]
+func CancelClubInvitation(c *gin.Context) {
+	membership := clubMembership(c)
+	if !requireClubRole(c, membership, models.ClubModerator) {
+		return
+	}
+
+	invitationID, err := strconv.ParseUint(c.Param("invitationId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz davet ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	result := config.DB.Where("id = ? AND club_id = ?", invitationID, membership.ClubID).Delete(&models.ClubInvitation{})
+	if result.Error != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Davet iptal edilemedi",
+			"error":   result.Error.Error(),
+		})
+		return
+	}
+	if result.RowsAffected == 0 {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Davet bulunamadı",
+			"error":   "record not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Davet iptal edildi",
+	})
+}
+
+// GetMyClubInvitations godoc
+// @Summary      Kulüp davetlerim
+// @Description  Kullanıcıya gönderilmiş bekleyen kulüp davetlerini listeler
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Success      200  {array}   models.ClubInvitationResponse
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/invitations [get]
+func GetMyClubInvitations(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	var invitations []models.ClubInvitation
+	err := config.DB.Preload("Club").Preload("InvitedBy").
+		Where("user_id = ?", userID).
+		Order("created_at DESC, id DESC").
+		Find(&invitations).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Davetler alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	response := make([]models.ClubInvitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		response = append(response, newClubInvitationResponse(invitation))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Davetler başarıyla getirildi",
+		"data":    response,
+	})
+}
+
+// findMyClubInvitation yol parametresindeki, kullanıcıya gönderilmiş daveti bulur.
+// Hata durumunda yanıtı yazar ve false döner.
+func findMyClubInvitation(c *gin.Context, userID interface{}) (models.ClubInvitation, bool) {
+	var invitation models.ClubInvitation
+
+	invitationID, err := strconv.ParseUint(c.Param("invitationId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz davet ID",
+			"error":   err.Error(),
+		})
+		return invitation, false
+	}
+
+	if err := config.DB.Preload("Club").Where("id = ? AND user_id = ?", invitationID, userID).First(&invitation).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Davet bulunamadı",
+			"error":   err.Error(),
+		})
+		return invitation, false
+	}
+	return invitation, true
+}
+
+// AcceptClubInvitation godoc
+// @Summary      Daveti kabul etme
+// @Description  Kulüp davetini kabul eder; kullanıcı kulübe member rolüyle katılır
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        invitationId  path      int  true  "Davet ID"
+// @Success      200           {object}  models.ClubResponse
+// @Failure      400           {object}  map[string]interface{}
+// @Failure      401           {object}  map[string]interface{}
+// @Failure      404           {object}  map[string]interface{}
+// @Failure      500           {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/invitations/{invitationId}/accept [post]
+func AcceptClubInvitation(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	invitation, ok := findMyClubInvitation(c, userID)
+	if !ok {
+		return
+	}
+
+	membership := models.ClubMembership{ClubID: invitation.ClubID, UserID: invitation.UserID, Role: models.ClubMember, Club: invitation.Club}
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Club").Create(&membership).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&invitation).Error
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Davet kabul edilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	response, err := clubResponses([]models.ClubMembership{membership})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kulüp alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Kulübe katıldınız",
+		"data":    response[0],
+	})
+}
+
+// DeclineClubInvitation godoc
+// @Summary      Daveti reddetme
+// @Description  Kullanıcıya gönderilmiş kulüp davetini reddeder
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        invitationId  path      int  true  "Davet ID"
+// @Success      200           {object}  map[string]interface{}
+// @Failure      400           {object}  map[string]interface{}
+// @Failure      401           {object}  map[string]interface{}
+// @Failure      404           {object}  map[string]interface{}
+// @Failure      500           {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/invitations/{invitationId} [delete]
+func DeclineClubInvitation(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	invitation, ok := findMyClubInvitation(c, userID)
+	if !ok {
+		return
+	}
+
+	if err := config.DB.Delete(&invitation).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Davet reddedilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Davet reddedildi",
+	})
+}
@@ -0,0 +1,602 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go-api/config"
+	"go-api/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// saveClubBook kulüp kitabını kaydeder. Kitap current yapılırsa kulübün önceki
+// current kitabı finished olur; kulübün aynı anda tek bir güncel kitabı vardır.
+func saveClubBook(tx *gorm.DB, book *models.ClubBook) error {
+	if book.Status == models.ClubBookCurrent {
+		err := tx.Model(&models.ClubBook{}).
+			Where("club_id = ? AND status = ? AND id <> ?", book.ClubID, models.ClubBookCurrent, book.ID).
+			Update("status", models.ClubBookFinished).Error
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Save(book).Error
+}
+
+// findClubBook yol parametresindeki kulüp kitabını bulur. Hata durumunda
+// yanıtı yazar ve false döner.
+func findClubBook(c *gin.Context, clubID uint) (models.ClubBook, bool) {
+	var book models.ClubBook
+
+	bookID, err := strconv.ParseUint(c.Param("bookId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kulüp kitabı ID",
+			"error":   err.Error(),
+		})
+		return book, false
+	}
+
+	if err := config.DB.Where("id = ? AND club_id = ?", bookID, clubID).First(&book).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kulüp kitabı bulunamadı",
+			"error":   err.Error(),
+		})
+		return book, false
+	}
+	return book, true
+}
+
+// findClubPost yol parametresindeki kulüp gönderisini bulur. Hata durumunda
+// yanıtı yazar ve false döner.
+func findClubPost(c *gin.Context, clubID uint) (models.ClubPost, bool) {
+	var post models.ClubPost
+
+	postID, err := strconv.ParseUint(c.Param("postId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz gönderi ID",
+			"error":   err.Error(),
+		})
+		return post, false
+	}
+
+	if err := config.DB.Where("id = ? AND club_id = ?", postID, clubID).First(&post).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Gönderi bulunamadı",
+			"error":   err.Error(),
+		})
+		return post, false
+	}
+	return post, true
+}
+
+// newClubPostResponse gönderiyi yanıta çevirir; showSpoilers false ise spoiler
+// işaretli gönderinin metni gizlenir. post.User yüklenmiş olmalıdır.
+func newClubPostResponse(post models.ClubPost, showSpoilers bool) models.ClubPostResponse {
+	response := models.ClubPostResponse{ClubPost: post, Replies: []models.ClubPostResponse{}}
+	if post.User != nil {
+		response.User = newPublicUser(*post.User)
+	}
+	if post.Spoiler && !showSpoilers {
+		response.Body = ""
+		response.Hidden = true
+	}
+	return response
+}
+
+// clubPostTree gönderileri yanıt ağacına çevirir. Gönderiler oluşturulma
+// sırasıyla verilmelidir; yanıtlar üst gönderinin altında aynı sırayla yer alır.
+func clubPostTree(posts []models.ClubPost, showSpoilers bool) []models.ClubPostResponse {
+	children := make(map[uint][]models.ClubPost)
+	var roots []models.ClubPost
+	for _, post := range posts {
+		if post.ParentID == nil {
+			roots = append(roots, post)
+		} else {
+			children[*post.ParentID] = append(children[*post.ParentID], post)
+		}
+	}
+
+	var build func(post models.ClubPost) models.ClubPostResponse
+	build = func(post models.ClubPost) models.ClubPostResponse {
+		response := newClubPostResponse(post, showSpoilers)
+		for _, child := range children[post.ID] {
+			response.Replies = append(response.Replies, build(child))
+		}
+		return response
+	}
+
+	tree := make([]models.ClubPostResponse, 0, len(roots))
+	for _, root := range roots {
+		tree = append(tree, build(root))
+	}
+	return tree
+}
+
+// GetClubBooks godoc
+// @Summary      Kulübün okuma listesi
+// @Description  Kulübün güncel, sıradaki ve bitirilmiş kitaplarını listeler
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int     true   "Kulüp ID"
+// @Param        status  query     string  false  "Durum (current, upcoming, finished)"
+// @Success      200     {array}   models.ClubBook
+// @Failure      400     {object}  map[string]interface{}
+// @Failure      401     {object}  map[string]interface{}
+// @Failure      404     {object}  map[string]interface{}
+// @Failure      500     {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/{id}/books [get]
+func GetClubBooks(c *gin.Context) {
+	membership := clubMembership(c)
+
+	query := config.DB.Where("club_id = ?", membership.ClubID)
+	if status := c.Query("status"); status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	books := []models.ClubBook{}
+	err := query.
+		Order("CASE status WHEN 'current' THEN 0 WHEN 'upcoming' THEN 1 ELSE 2 END").
+		Order("position, updated_at DESC, id").
+		Find(&books).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Okuma listesi alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Okuma listesi başarıyla getirildi",
+		"data":    books,
+	})
+}
+
+// AddClubBook godoc
+// @Summary      Okuma listesine kitap ekleme
+// @Description  Kullanıcının kütüphanesindeki bir kitabı kulübün okuma listesine ekler; moderator veya owner rolü gerekir. current olarak eklenen kitap önceki güncel kitabı finished yapar.
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int                     true  "Kulüp ID"
+// @Param        book  body      models.ClubBookRequest  true  "Kitap"
+// @Success      201   {object}  models.ClubBook
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      403   {object}  map[string]interface{}
+// @Failure      404   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/{id}/books [post]
+func AddClubBook(c *gin.Context) {
+	membership := clubMembership(c)
+	if !requireClubRole(c, membership, models.ClubModerator) {
+		return
+	}
+
+	var request models.ClubBookRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	book, err := findUserBook(config.DB, membership.UserID, uint64(request.BookID))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	clubBook := models.ClubBook{
+		ClubID:    membership.ClubID,
+		BookID:    &book.ID,
+		AddedByID: membership.UserID,
+		Title:     book.Title,
+		Author:    book.Author,
+		Status:    request.Status,
+		Position:  request.Position,
+	}
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		return saveClubBook(tx, &clubBook)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kitap okuma listesine eklenemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"status":  "success",
+		"message": "Kitap okuma listesine eklendi",
+		"data":    clubBook,
+	})
+}
+
+// UpdateClubBook godoc
+// @Summary      Okuma listesindeki kitabı güncelleme
+// @Description  Kulüp kitabının durumunu ve sırasını değiştirir; moderator veya owner rolü gerekir
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int                           true  "Kulüp ID"
+// @Param        bookId  path      int                           true  "Kulüp kitabı ID"
+// @Param        book    body      models.ClubBookUpdateRequest  true  "Durum ve sıra"
+// @Success      200     {object}  models.ClubBook
+// @Failure      400     {object}  map[string]interface{}
+// @Failure      401     {object}  map[string]interface{}
+// @Failure      403     {object}  map[string]interface{}
+// @Failure      404     {object}  map[string]interface{}
+// @Failure      500     {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/{id}/books/{bookId} [put]
+func UpdateClubBook(c *gin.Context) {
+	membership := clubMembership(c)
+	if !requireClubRole(c, membership, models.ClubModerator) {
+		return
+	}
+
+	clubBook, ok := findClubBook(c, membership.ClubID)
+	if !ok {
+		return
+	}
+
+	var request models.ClubBookUpdateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	clubBook.Status = request.Status
+	clubBook.Position = request.Position
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		return saveClubBook(tx, &clubBook)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kulüp kitabı güncellenemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Kulüp kitabı başarıyla güncellendi",
+		"data":    clubBook,
+	})
+}
+
+// RemoveClubBook godoc
+// @Summary      Okuma listesinden kitap çıkarma
+// @Description  Kitabı kulübün okuma listesinden tartışmalarıyla birlikte çıkarır; moderator veya owner rolü gerekir
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int  true  "Kulüp ID"
+// @Param        bookId  path      int  true  "Kulüp kitabı ID"
+// @Success      200     {object}  map[string]interface{}
+// @Failure      400     {object}  map[string]interface{}
+// @Failure      401     {object}  map[string]interface{}
+// @Failure      403     {object}  map[string]interface{}
+// @Failure      404     {object}  map[string]interface{}
+// @Failure      500     {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/{id}/books/{bookId} [delete]
+func RemoveClubBook(c *gin.Context) {
+	membership := clubMembership(c)
+	if !requireClubRole(c, membership, models.ClubModerator) {
+		return
+	}
+
+	clubBook, ok := findClubBook(c, membership.ClubID)
+	if !ok {
+		return
+	}
+
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("club_book_id = ?", clubBook.ID).Delete(&models.ClubPost{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&clubBook).Error
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kitap okuma listesinden çıkarılamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Kitap okuma listesinden çıkarıldı",
+	})
+}
+
+// GetClubBookPosts godoc
+// @Summary      Kitap tartışması
+// @Description  Kulüp kitabının tartışmasını yanıtlarıyla birlikte ağaç olarak getirir. Spoiler işaretli gönderilerin metni show_spoilers=true verilmedikçe gizlenir.
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        id             path      int   true   "Kulüp ID"
+// @Param        bookId         path      int   true   "Kulüp kitabı ID"
+// @Param        show_spoilers  query     bool  false  "Spoiler içeren gönderileri göster"
+// @Success      200            {array}   models.ClubPostResponse
+// @Failure      400            {object}  map[string]interface{}
+// @Failure      401            {object}  map[string]interface{}
+// @Failure      404            {object}  map[string]interface{}
+// @Failure      500            {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/{id}/books/{bookId}/posts [get]
+func GetClubBookPosts(c *gin.Context) {
+	membership := clubMembership(c)
+
+	clubBook, ok := findClubBook(c, membership.ClubID)
+	if !ok {
+		return
+	}
+
+	var posts []models.ClubPost
+	err := config.DB.Preload("User").Where("club_book_id = ?", clubBook.ID).Order("created_at, id").Find(&posts).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Gönderiler alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Gönderiler başarıyla getirildi",
+		"data":    clubPostTree(posts, c.Query("show_spoilers") == "true"),
+	})
+}
+
+// CreateClubBookPost godoc
+// @Summary      Tartışmaya gönderi ekleme
+// @Description  Kulüp kitabının tartışmasına gönderi ekler; parent_id verilirse aynı kitaptaki bir gönderiye yanıt olur
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int                     true  "Kulüp ID"
+// @Param        bookId  path      int                     true  "Kulüp kitabı ID"
+// @Param        post    body      models.ClubPostRequest  true  "Gönderi"
+// @Success      201     {object}  models.ClubPostResponse
+// @Failure      400     {object}  map[string]interface{}
+// @Failure      401     {object}  map[string]interface{}
+// @Failure      404     {object}  map[string]interface{}
+// @Failure      500     {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/{id}/books/{bookId}/posts [post]
+func CreateClubBookPost(c *gin.Context) {
+	membership := clubMembership(c)
+
+	clubBook, ok := findClubBook(c, membership.ClubID)
+	if !ok {
+		return
+	}
+
+	var request models.ClubPostRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+	body := strings.TrimSpace(request.Body)
+	if body == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   "gönderi boş olamaz",
+		})
+		return
+	}
+
+	if request.ParentID != nil {
+		var count int64
+		err := config.DB.Model(&models.ClubPost{}).Where("id = ? AND club_book_id = ?", *request.ParentID, clubBook.ID).Count(&count).Error
+		if err == nil && count == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"status":  "error",
+				"message": "Geçersiz istek",
+				"error":   "yanıtlanan gönderi bu kitabın tartışmasında bulunamadı",
+			})
+			return
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"status":  "error",
+				"message": "Gönderi eklenemedi",
+				"error":   err.Error(),
+			})
+			return
+		}
+	}
+
+	post := models.ClubPost{
+		ClubID:     membership.ClubID,
+		ClubBookID: clubBook.ID,
+		UserID:     membership.UserID,
+		ParentID:   request.ParentID,
+		Body:       body,
+		Spoiler:    request.Spoiler,
+	}
+	err := config.DB.Create(&post).Error
+	if err == nil {
+		err = config.DB.First(&post.User, post.UserID).Error
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Gönderi eklenemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"status":  "success",
+		"message": "Gönderi başarıyla eklendi",
+		"data":    newClubPostResponse(post, true),
+	})
+}
+
+// UpdateClubPost godoc
+// @Summary      Gönderi düzenleme
+// @Description  Gönderinin metnini ve spoiler işaretini değiştirir; yalnızca gönderinin yazarı düzenleyebilir
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int                           true  "Kulüp ID"
+// @Param        postId  path      int                           true  "Gönderi ID"
+// @Param        post    body      models.ClubPostUpdateRequest  true  "Gönderi"
+// @Success      200     {object}  models.ClubPostResponse
+// @Failure      400     {object}  map[string]interface{}
+// @Failure      401     {object}  map[string]interface{}
+// @Failure      403     {object}  map[string]interface{}
+// @Failure      404     {object}  map[string]interface{}
+// @Failure      500     {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/{id}/posts/{postId} [put]
+func UpdateClubPost(c *gin.Context) {
+	membership := clubMembership(c)
+
+	post, ok := findClubPost(c, membership.ClubID)
+	if !ok {
+		return
+	}
+	if post.UserID != membership.UserID {
+		c.JSON(http.StatusForbidden, gin.H{
+			"status":  "error",
+			"message": "Bu işlem için yetkiniz yok",
+			"error":   "gönderiyi yalnızca yazarı düzenleyebilir",
+		})
+		return
+	}
+
+	var request models.ClubPostUpdateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+	body := strings.TrimSpace(request.Body)
+	if body == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   "gönderi boş olamaz",
+		})
+		return
+	}
+
+	post.Body = body
+	post.Spoiler = request.Spoiler
+	if err := config.DB.Save(&post).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Gönderi güncellenemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if err := config.DB.First(&post.User, post.UserID).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kullanıcı bilgileri alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Gönderi başarıyla güncellendi",
+		"data":    newClubPostResponse(post, true),
+	})
+}
+
+// DeleteClubPost godoc
+// @Summary      Gönderi silme
+// @Description  Gönderiyi tüm yanıtlarıyla birlikte siler; gönderinin yazarı veya moderator/owner silebilir
+// @Tags         clubs
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int  true  "Kulüp ID"
+// @Param        postId  path      int  true  "Gönderi ID"
+// @Success      200     {object}  map[string]interface{}
+// @Failure      400     {object}  map[string]interface{}
+// @Failure      401     {object}  map[string]interface{}
+// @Failure      403     {object}  map[string]interface{}
+// @Failure      404     {object}  map[string]interface{}
+// @Failure      500     {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/clubs/{id}/posts/{postId} [delete]
+func DeleteClubPost(c *gin.Context) {
+	membership := clubMembership(c)
+
+	post, ok := findClubPost(c, membership.ClubID)
+	if !ok {
+		return
+	}
+	if post.UserID != membership.UserID && !requireClubRole(c, membership, models.ClubModerator) {
+		return
+	}
+
+	// Yanıt zinciri özyinelemeli olarak bulunur
+	err := config.DB.Exec(`WITH RECURSIVE thread(id) AS (
+		SELECT ? UNION ALL
+		SELECT club_posts.id FROM club_posts JOIN thread ON club_posts.parent_id = thread.id
+	) DELETE FROM club_posts WHERE id IN (SELECT id FROM thread)`, post.ID).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Gönderi silinemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Gönderi başarıyla silindi",
+	})
+}
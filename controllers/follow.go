@@ -0,0 +1,460 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"go-api/config"
+	"go-api/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// listFollows takip kayıtlarını verilen ilişkideki kullanıcıyla birlikte listeler
+func listFollows(c *gin.Context, query *gorm.DB, relation string, message string) {
+	var follows []models.Follow
+	if err := query.Preload(relation).Order("created_at DESC, id DESC").Find(&follows).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Takip listesi alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	response := make([]models.FollowResponse, 0, len(follows))
+	for _, follow := range follows {
+		user := follow.Follower
+		if relation == "Followee" {
+			user = follow.Followee
+		}
+		// Silinmiş kullanıcılar listelenmez
+		if user == nil {
+			continue
+		}
+		response = append(response, models.FollowResponse{
+			ID:        follow.ID,
+			Status:    follow.Status,
+			User:      newPublicUser(*user),
+			CreatedAt: follow.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": message,
+		"data":    response,
+	})
+}
+
+// FollowUser godoc
+// @Summary      Kullanıcı takip etme
+// @Description  Kullanıcıyı takip eder. Hesap private ise istek onaylanana kadar pending kalır. Zaten takip ediliyorsa mevcut kayıt döner.
+// @Tags         follows
+// @Accept       json
+// @Produce      json
+// @Param        username  path      string  true  "Kullanıcı adı"
+// @Success      200       {object}  models.FollowResponse
+// @Success      201       {object}  models.FollowResponse
+// @Failure      400       {object}  map[string]interface{}
+// @Failure      401       {object}  map[string]interface{}
+// @Failure      404       {object}  map[string]interface{}
+// @Failure      500       {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/users/{username}/follow [post]
+func FollowUser(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	var followee models.User
+	if err := config.DB.Where("username = ?", c.Param("username")).First(&followee).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kullanıcı bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if followee.ID == userID.(uint) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   "kullanıcı kendini takip edemez",
+		})
+		return
+	}
+
+	follow := models.Follow{FollowerID: userID.(uint), FolloweeID: followee.ID}
+	status := http.StatusOK
+	err := config.DB.Where(&follow).First(&follow).Error
+	if err == gorm.ErrRecordNotFound {
+		follow.Status = models.FollowAccepted
+		notificationType := models.NotificationFollowed
+		if followee.PrivateAccount {
+			follow.Status = models.FollowPending
+			notificationType = models.NotificationFollowRequested
+		}
+		err = config.DB.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Create(&follow).Error; err != nil {
+				return err
+			}
+			return notifyFollow(tx, follow, notificationType)
+		})
+		status = http.StatusCreated
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kullanıcı takip edilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	message := "Kullanıcı takip ediliyor"
+	if follow.Status == models.FollowPending {
+		message = "Takip isteği gönderildi"
+	}
+	c.JSON(status, gin.H{
+		"status":  "success",
+		"message": message,
+		"data": models.FollowResponse{
+			ID:        follow.ID,
+			Status:    follow.Status,
+			User:      newPublicUser(followee),
+			CreatedAt: follow.CreatedAt,
+		},
+	})
+}
+
+// UnfollowUser godoc
+// @Summary      Takibi bırakma
+// @Description  Kullanıcıyı takip etmeyi bırakır veya bekleyen takip isteğini geri çeker
+// @Tags         follows
+// @Accept       json
+// @Produce      json
+// @Param        username  path      string  true  "Kullanıcı adı"
+// @Success      200       {object}  map[string]interface{}
+// @Failure      401       {object}  map[string]interface{}
+// @Failure      404       {object}  map[string]interface{}
+// @Failure      500       {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/users/{username}/follow [delete]
+func UnfollowUser(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	result := config.DB.
+		Where("follower_id = ? AND followee_id = (SELECT id FROM users WHERE username = ? AND deleted_at IS NULL)", userID, c.Param("username")).
+		Delete(&models.Follow{})
+	if result.Error != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Takip bırakılamadı",
+			"error":   result.Error.Error(),
+		})
+		return
+	}
+	if result.RowsAffected == 0 {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Takip bulunamadı",
+			"error":   "record not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Takip bırakıldı",
+	})
+}
+
+// GetFollowers godoc
+// @Summary      Takipçiler
+// @Description  Kullanıcıyı takip edenleri listeler; bekleyen istekler dahil değildir
+// @Tags         follows
+// @Accept       json
+// @Produce      json
+// @Success      200  {array}   models.FollowResponse
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/follows/followers [get]
+func GetFollowers(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	query := config.DB.Where("followee_id = ? AND status = ?", userID, models.FollowAccepted)
+	listFollows(c, query, "Follower", "Takipçiler başarıyla getirildi")
+}
+
+// GetFollowing godoc
+// @Summary      Takip edilenler
+// @Description  Kullanıcının takip ettiklerini ve bekleyen takip isteklerini listeler
+// @Tags         follows
+// @Accept       json
+// @Produce      json
+// @Success      200  {array}   models.FollowResponse
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/follows/following [get]
+func GetFollowing(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	query := config.DB.Where("follower_id = ?", userID)
+	listFollows(c, query, "Followee", "Takip edilenler başarıyla getirildi")
+}
+
+// GetFollowRequests godoc
+// @Summary      Takip istekleri
+// @Description  Kullanıcıya gelen ve onay bekleyen takip isteklerini listeler
+// @Tags         follows
+// @Accept       json
+// @Produce      json
+// @Success      200  {array}   models.FollowResponse
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/follows/requests [get]
+func GetFollowRequests(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	query := config.DB.Where("followee_id = ? AND status = ?", userID, models.FollowPending)
+	listFollows(c, query, "Follower", "Takip istekleri başarıyla getirildi")
+}
+
+// ApproveFollowRequest godoc
+// @Summary      Takip isteğini onaylama
+// @Description  Kullanıcıya gelen bekleyen takip isteğini onaylar
+// @Tags         follows
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Takip isteği ID"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/follows/requests/{id}/approve [post]
+func ApproveFollowRequest(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	followID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz takip isteği ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var follow models.Follow
+	err = config.DB.Where("id = ? AND followee_id = ? AND status = ?", followID, userID, models.FollowPending).First(&follow).Error
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Takip isteği bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&follow).Update("status", models.FollowAccepted).Error; err != nil {
+			return err
+		}
+		return notifyFollow(tx, follow, models.NotificationFollowAccepted)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Takip isteği onaylanamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Takip isteği onaylandı",
+	})
+}
+
+// RemoveFollower godoc
+// @Summary      Takip isteğini reddetme veya takipçiyi çıkarma
+// @Description  Kullanıcıya gelen takip isteğini reddeder ya da mevcut bir takipçiyi çıkarır
+// @Tags         follows
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Takip ID"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/follows/requests/{id} [delete]
+func RemoveFollower(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	followID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz takip ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	result := config.DB.Where("id = ? AND followee_id = ?", followID, userID).Delete(&models.Follow{})
+	if result.Error != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Takip kaldırılamadı",
+			"error":   result.Error.Error(),
+		})
+		return
+	}
+	if result.RowsAffected == 0 {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Takip bulunamadı",
+			"error":   "record not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Takip kaldırıldı",
+	})
+}
+
+// UpdateFollowSettings godoc
+// @Summary      Hesap gizliliği
+// @Description  Hesabı private veya açık yapar. Private hesaba gelen takip istekleri onay bekler; hesap açık yapıldığında bekleyen istekler onaylanır.
+// @Tags         follows
+// @Accept       json
+// @Produce      json
+// @Param        settings  body      models.FollowSettingsRequest  true  "Gizlilik ayarı"
+// @Success      200       {object}  map[string]interface{}
+// @Failure      400       {object}  map[string]interface{}
+// @Failure      401       {object}  map[string]interface{}
+// @Failure      500       {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/follows/settings [put]
+func UpdateFollowSettings(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	var request models.FollowSettingsRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.User{}).Where("id = ?", userID).Update("private_account", *request.PrivateAccount).Error
+		if err != nil || *request.PrivateAccount {
+			return err
+		}
+
+		var pending []models.Follow
+		if err := tx.Where("followee_id = ? AND status = ?", userID, models.FollowPending).Find(&pending).Error; err != nil {
+			return err
+		}
+		for _, follow := range pending {
+			if err := tx.Model(&follow).Update("status", models.FollowAccepted).Error; err != nil {
+				return err
+			}
+			if err := notifyFollow(tx, follow, models.NotificationFollowAccepted); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Gizlilik ayarı kaydedilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Gizlilik ayarı kaydedildi",
+		"data":    gin.H{"private_account": *request.PrivateAccount},
+	})
+}
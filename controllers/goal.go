@@ -0,0 +1,781 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-api/config"
+	"go-api/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// parseGoalYear yol parametresindeki yılı okur
+func parseGoalYear(c *gin.Context) (int, error) {
+	year, err := strconv.Atoi(c.Param("year"))
+	if err != nil || year < 1 || year > 9999 {
+		return 0, fmt.Errorf("geçersiz yıl: %q", c.Param("year"))
+	}
+	return year, nil
+}
+
+// validateChallenge challenge türünün gerektirdiği value değerini doğrular
+func validateChallenge(request models.ReadingChallengeRequest) error {
+	switch request.Type {
+	case models.ChallengeTag:
+		if strings.TrimSpace(request.Value) == "" {
+			return errors.New("tag türü için value alanı zorunludur")
+		}
+	case models.ChallengeMinPages, models.ChallengePublishedBefore:
+		if value, err := strconv.Atoi(request.Value); err != nil || value < 1 {
+			return fmt.Errorf("%s türü için value pozitif bir tam sayı olmalıdır", request.Type)
+		}
+	}
+	return nil
+}
+
+// goalPacing bir hedefin ilerlemesini yılın geçen kısmına göre hesaplar.
+// amount her okumanın hedefe katkısıdır; okumalar bitiş tarihine göre sıralı olmalıdır.
+func goalPacing(target int, reads []statsRead, amount func(statsRead) int, year int, now time.Time) *models.GoalPacing {
+	if target <= 0 {
+		return nil
+	}
+
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+	yearDays := int(end.Sub(start).Hours() / 24)
+	elapsed := 0
+	switch {
+	case !now.Before(end):
+		elapsed = yearDays
+	case !now.Before(start):
+		elapsed = int(now.Sub(start).Hours()/24) + 1
+	}
+
+	pacing := &models.GoalPacing{Target: target, Status: "behind"}
+	for _, read := range reads {
+		pacing.Read += amount(read)
+		if pacing.ProjectedCompletion == nil && pacing.Read >= target {
+			date := read.ReadDate
+			pacing.ProjectedCompletion = &date
+		}
+	}
+	pacing.Percent = math.Round(float64(pacing.Read)/float64(target)*1000) / 10
+	pacing.Expected = target * elapsed / yearDays
+	pacing.Projected = pacing.Read
+	if elapsed > 0 {
+		pacing.Projected = int(math.Round(float64(pacing.Read) * float64(yearDays) / float64(elapsed)))
+	}
+
+	switch {
+	case pacing.Read >= target:
+		pacing.Status = "completed"
+	case pacing.Read >= pacing.Expected:
+		pacing.Status = "on_track"
+	}
+
+	// Yıl bitmediyse hedefe mevcut günlük hızla ulaşılacak gün tahmin edilir
+	if pacing.ProjectedCompletion == nil && pacing.Read > 0 && elapsed > 0 && elapsed < yearDays {
+		days := int(math.Ceil(float64(target) * float64(elapsed) / float64(pacing.Read)))
+		date := start.AddDate(0, 0, days-1)
+		pacing.ProjectedCompletion = &date
+	}
+	return pacing
+}
+
+// newAuthorBooks yıl içindeki okumalardan, yıldan önce hiç okunmamış en az bir
+// yazarı olan kitapları döner
+func newAuthorBooks(userID interface{}, year int, reads []statsRead) (map[uint]bool, error) {
+	books := map[uint]bool{}
+	if len(reads) == 0 {
+		return books, nil
+	}
+
+	before := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+	var known []uint
+	err := userReadsScope(userID, nil, &before).
+		Joins("JOIN book_authors ON book_authors.book_id = books.id").
+		Distinct().
+		Pluck("book_authors.author_id", &known).Error
+	if err != nil {
+		return nil, err
+	}
+	knownAuthors := make(map[uint]bool, len(known))
+	for _, id := range known {
+		knownAuthors[id] = true
+	}
+
+	bookIDs := make([]uint, 0, len(reads))
+	for _, read := range reads {
+		bookIDs = append(bookIDs, read.BookID)
+	}
+	var links []struct {
+		BookID   uint
+		AuthorID uint
+	}
+	if err := config.DB.Table("book_authors").Where("book_id IN ?", uniqueIDs(bookIDs)).Find(&links).Error; err != nil {
+		return nil, err
+	}
+	for _, link := range links {
+		if !knownAuthors[link.AuthorID] {
+			books[link.BookID] = true
+		}
+	}
+	return books, nil
+}
+
+// challengeProgress challenge'ı yıl içindeki okumalarla değerlendirir
+func challengeProgress(challenge models.ReadingChallenge, reads []statsRead, newAuthors map[uint]bool) models.ChallengeProgress {
+	progress := models.ChallengeProgress{ReadingChallenge: challenge}
+	number, _ := strconv.Atoi(challenge.Value)
+
+	counted := map[uint]bool{}
+	for _, read := range reads {
+		if challenge.Type == models.ChallengePages {
+			progress.Progress += read.PageCount
+			continue
+		}
+
+		var matches bool
+		switch challenge.Type {
+		case models.ChallengeBooks:
+			matches = true
+		case models.ChallengeNewAuthors:
+			matches = newAuthors[read.BookID]
+		case models.ChallengeTag:
+			for _, tag := range read.Tags {
+				if strings.EqualFold(tag, strings.TrimSpace(challenge.Value)) {
+					matches = true
+					break
+				}
+			}
+		case models.ChallengeMinPages:
+			matches = read.PageCount >= number
+		case models.ChallengePublishedBefore:
+			matches = read.PublishYear > 0 && read.PublishYear < number
+		}
+		if matches && !counted[read.BookID] {
+			counted[read.BookID] = true
+			progress.Progress++
+		}
+	}
+	progress.Completed = progress.Progress >= challenge.Target
+	return progress
+}
+
+// yearGoalProgress yılın okumalarından hedef ve challenge ilerlemesini hesaplar.
+// goal nil olabilir; bu durumda yalnızca okunanlar ve challenge'lar döner.
+func yearGoalProgress(userID interface{}, year int, goal *models.ReadingGoal, challenges []models.ReadingChallenge) (models.ReadingGoalResponse, error) {
+	response := models.ReadingGoalResponse{Year: year, Challenges: []models.ChallengeProgress{}}
+
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+	reads, err := scanStatsReads(userReadsScope(userID, &from, &to))
+	if err != nil {
+		return response, err
+	}
+
+	response.BooksRead = len(reads)
+	for _, read := range reads {
+		response.PagesRead += read.PageCount
+	}
+
+	now := time.Now().UTC()
+	if goal != nil {
+		response.Books = goalPacing(goal.TargetBooks, reads, func(statsRead) int { return 1 }, year, now)
+		response.Pages = goalPacing(goal.TargetPages, reads, func(read statsRead) int { return read.PageCount }, year, now)
+	}
+
+	var newAuthors map[uint]bool
+	for _, challenge := range challenges {
+		if challenge.Type == models.ChallengeNewAuthors && newAuthors == nil {
+			if newAuthors, err = newAuthorBooks(userID, year, reads); err != nil {
+				return response, err
+			}
+		}
+		response.Challenges = append(response.Challenges, challengeProgress(challenge, reads, newAuthors))
+	}
+	return response, nil
+}
+
+// loadYearGoal kullanıcının yıl hedefini ve challenge'larını yükler; hedef yoksa goal nil döner
+func loadYearGoal(userID interface{}, year int) (*models.ReadingGoal, []models.ReadingChallenge, error) {
+	var goal models.ReadingGoal
+	err := config.DB.Where("user_id = ? AND year = ?", userID, year).First(&goal).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, err
+	}
+
+	var challenges []models.ReadingChallenge
+	if err := config.DB.Where("user_id = ? AND year = ?", userID, year).Order("id").Find(&challenges).Error; err != nil {
+		return nil, nil, err
+	}
+	if goal.ID == 0 {
+		return nil, challenges, nil
+	}
+	return &goal, challenges, nil
+}
+
+// userYearGoal yılın hedefini ve challenge'larını yükleyip ilerlemeyi hesaplar
+func userYearGoal(userID interface{}, year int) (models.ReadingGoalResponse, error) {
+	goal, challenges, err := loadYearGoal(userID, year)
+	if err != nil {
+		return models.ReadingGoalResponse{}, err
+	}
+	return yearGoalProgress(userID, year, goal, challenges)
+}
+
+// GetGoals godoc
+// @Summary      Okuma hedefleri
+// @Description  Hedef veya challenge belirlenmiş tüm yılları ilerlemeleriyle, yeniden eskiye listeler
+// @Tags         goals
+// @Accept       json
+// @Produce      json
+// @Success      200  {array}   models.ReadingGoalResponse
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/goals [get]
+func GetGoals(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	var goalYears, challengeYears []int
+	if err := config.DB.Model(&models.ReadingGoal{}).Where("user_id = ?", userID).Pluck("year", &goalYears).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Hedefler alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if err := config.DB.Model(&models.ReadingChallenge{}).Where("user_id = ?", userID).Distinct().Pluck("year", &challengeYears).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Hedefler alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	years := map[int]bool{}
+	for _, year := range append(goalYears, challengeYears...) {
+		years[year] = true
+	}
+	sorted := sortedKeys(years)
+	sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+
+	response := make([]models.ReadingGoalResponse, 0, len(sorted))
+	for _, year := range sorted {
+		progress, err := userYearGoal(userID, year)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"status":  "error",
+				"message": "Hedefler alınamadı",
+				"error":   err.Error(),
+			})
+			return
+		}
+		response = append(response, progress)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Hedefler başarıyla getirildi",
+		"data":    response,
+	})
+}
+
+// GetGoal godoc
+// @Summary      Yıllık hedef ilerlemesi
+// @Description  Yılın kitap/sayfa hedefine göre ilerlemeyi, hedefin gerisinde olup olmadığını, yıl sonu tahminini ve challenge'ların durumunu döner
+// @Tags         goals
+// @Accept       json
+// @Produce      json
+// @Param        year  path      int  true  "Yıl"
+// @Success      200   {object}  models.ReadingGoalResponse
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      404   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/goals/{year} [get]
+func GetGoal(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	year, err := parseGoalYear(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz yıl",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	goal, challenges, err := loadYearGoal(userID, year)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Hedef alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if goal == nil && len(challenges) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Hedef bulunamadı",
+			"error":   fmt.Sprintf("%d için hedef veya challenge yok", year),
+		})
+		return
+	}
+
+	response, err := yearGoalProgress(userID, year, goal, challenges)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Hedef ilerlemesi hesaplanamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Hedef başarıyla getirildi",
+		"data":    response,
+	})
+}
+
+// SetGoal godoc
+// @Summary      Yıllık hedef belirleme
+// @Description  Yılın kitap ve/veya sayfa hedefini oluşturur ya da günceller. 0 olan hedef belirlenmemiş sayılır; en az biri verilmelidir.
+// @Tags         goals
+// @Accept       json
+// @Produce      json
+// @Param        year  path      int                        true  "Yıl"
+// @Param        goal  body      models.ReadingGoalRequest  true  "Hedefler"
+// @Success      200   {object}  models.ReadingGoalResponse
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/goals/{year} [put]
+func SetGoal(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	year, err := parseGoalYear(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz yıl",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var request models.ReadingGoalRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	goal, challenges, err := loadYearGoal(userID, year)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Hedef kaydedilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if goal == nil {
+		goal = &models.ReadingGoal{UserID: userID.(uint), Year: year}
+	}
+	// Hedef değişince eşik bildirimleri yeni hedefe göre baştan gönderilir
+	if goal.TargetBooks != request.TargetBooks || goal.TargetPages != request.TargetPages {
+		goal.NotifiedMilestone = 0
+	}
+	goal.TargetBooks = request.TargetBooks
+	goal.TargetPages = request.TargetPages
+	if err := config.DB.Save(goal).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Hedef kaydedilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	response, err := yearGoalProgress(userID, year, goal, challenges)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Hedef ilerlemesi hesaplanamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Hedef başarıyla kaydedildi",
+		"data":    response,
+	})
+}
+
+// DeleteGoal godoc
+// @Summary      Yıllık hedefi silme
+// @Description  Yılın kitap/sayfa hedefini siler; challenge'lar korunur
+// @Tags         goals
+// @Accept       json
+// @Produce      json
+// @Param        year  path      int  true  "Yıl"
+// @Success      200   {object}  map[string]interface{}
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      404   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/goals/{year} [delete]
+func DeleteGoal(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	year, err := parseGoalYear(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz yıl",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	result := config.DB.Where("user_id = ? AND year = ?", userID, year).Delete(&models.ReadingGoal{})
+	if result.Error != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Hedef silinemedi",
+			"error":   result.Error.Error(),
+		})
+		return
+	}
+	if result.RowsAffected == 0 {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Hedef bulunamadı",
+			"error":   "record not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Hedef başarıyla silindi",
+	})
+}
+
+// CreateChallenge godoc
+// @Summary      Challenge ekleme
+// @Description  Yıl için özel bir challenge ekler. Türler: books, pages, new_authors, tag (value: etiket), min_pages (value: sayfa sayısı), published_before (value: yıl).
+// @Tags         goals
+// @Accept       json
+// @Produce      json
+// @Param        year       path      int                             true  "Yıl"
+// @Param        challenge  body      models.ReadingChallengeRequest  true  "Challenge bilgileri"
+// @Success      201        {object}  models.ChallengeProgress
+// @Failure      400        {object}  map[string]interface{}
+// @Failure      401        {object}  map[string]interface{}
+// @Failure      500        {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/goals/{year}/challenges [post]
+func CreateChallenge(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	year, err := parseGoalYear(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz yıl",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var request models.ReadingChallengeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if err := validateChallenge(request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	challenge := models.ReadingChallenge{
+		UserID: userID.(uint),
+		Year:   year,
+		Name:   strings.TrimSpace(request.Name),
+		Type:   request.Type,
+		Target: request.Target,
+		Value:  strings.TrimSpace(request.Value),
+	}
+	if err := config.DB.Create(&challenge).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Challenge kaydedilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	response, err := yearGoalProgress(userID, year, nil, []models.ReadingChallenge{challenge})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Challenge ilerlemesi hesaplanamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"status":  "success",
+		"message": "Challenge başarıyla eklendi",
+		"data":    response.Challenges[0],
+	})
+}
+
+// UpdateChallenge godoc
+// @Summary      Challenge güncelleme
+// @Description  Yılın challenge'ını günceller
+// @Tags         goals
+// @Accept       json
+// @Produce      json
+// @Param        year       path      int                             true  "Yıl"
+// @Param        id         path      int                             true  "Challenge ID"
+// @Param        challenge  body      models.ReadingChallengeRequest  true  "Challenge bilgileri"
+// @Success      200        {object}  models.ChallengeProgress
+// @Failure      400        {object}  map[string]interface{}
+// @Failure      401        {object}  map[string]interface{}
+// @Failure      404        {object}  map[string]interface{}
+// @Failure      500        {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/goals/{year}/challenges/{id} [put]
+func UpdateChallenge(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	year, err := parseGoalYear(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz yıl",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	challengeID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz challenge ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var challenge models.ReadingChallenge
+	if err := config.DB.Where("id = ? AND user_id = ? AND year = ?", challengeID, userID, year).First(&challenge).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Challenge bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var request models.ReadingChallengeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if err := validateChallenge(request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	challenge.Name = strings.TrimSpace(request.Name)
+	challenge.Type = request.Type
+	challenge.Target = request.Target
+	challenge.Value = strings.TrimSpace(request.Value)
+	if err := config.DB.Save(&challenge).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Challenge güncellenemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	response, err := yearGoalProgress(userID, year, nil, []models.ReadingChallenge{challenge})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Challenge ilerlemesi hesaplanamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Challenge başarıyla güncellendi",
+		"data":    response.Challenges[0],
+	})
+}
+
+// DeleteChallenge godoc
+// @Summary      Challenge silme
+// @Description  Yılın challenge'ını siler
+// @Tags         goals
+// @Accept       json
+// @Produce      json
+// @Param        year  path      int  true  "Yıl"
+// @Param        id    path      int  true  "Challenge ID"
+// @Success      200   {object}  map[string]interface{}
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      404   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/goals/{year}/challenges/{id} [delete]
+func DeleteChallenge(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	year, err := parseGoalYear(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz yıl",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	challengeID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz challenge ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	result := config.DB.Where("id = ? AND user_id = ? AND year = ?", challengeID, userID, year).Delete(&models.ReadingChallenge{})
+	if result.Error != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Challenge silinemedi",
+			"error":   result.Error.Error(),
+		})
+		return
+	}
+	if result.RowsAffected == 0 {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Challenge bulunamadı",
+			"error":   "record not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Challenge başarıyla silindi",
+	})
+}
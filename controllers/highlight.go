@@ -0,0 +1,375 @@
+package controllers
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"go-api/config"
+	"go-api/models"
+	"go-api/utils"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// kindleSubtitlePattern Kindle başlıklarındaki seri/alt başlık eklerini ayıklar
+var kindleSubtitlePattern = regexp.MustCompile(`\s*(\(.*\)|\[.*\]|:.*)$`)
+
+// highlightHash aynı vurgunun tekrar içe aktarılmasını önleyen anahtar
+func highlightHash(bookID uint, location, text string) string {
+	sum := sha1.Sum([]byte(fmt.Sprintf("%d|%s|%s", bookID, location, text)))
+	return hex.EncodeToString(sum[:])
+}
+
+// kindleBookMatcher kupürleri kullanıcının mevcut kitaplarıyla eşleştirir
+type kindleBookMatcher struct {
+	byKey   map[string]uint
+	byTitle map[string][]uint
+}
+
+func newKindleBookMatcher(userID uint) (*kindleBookMatcher, error) {
+	var books []models.Book
+	if err := config.DB.Select("id", "title", "author").Where("user_id = ?", userID).Find(&books).Error; err != nil {
+		return nil, err
+	}
+
+	matcher := &kindleBookMatcher{byKey: map[string]uint{}, byTitle: map[string][]uint{}}
+	for _, book := range books {
+		matcher.add(book)
+	}
+	return matcher, nil
+}
+
+func (m *kindleBookMatcher) add(book models.Book) {
+	m.byKey[bookDuplicateKey(book.Title, book.Author)] = book.ID
+	titleKey := bookDuplicateKey(book.Title, "")
+	m.byTitle[titleKey] = append(m.byTitle[titleKey], book.ID)
+}
+
+// match önce başlık ve yazar, sonra yalnızca başlık (tek eşleşme varsa)
+// ile kitabı bulur; alt başlıklı Kindle başlıkları sadeleştirilerek de denenir
+func (m *kindleBookMatcher) match(title, author string) (uint, bool) {
+	for _, candidate := range []string{title, kindleSubtitlePattern.ReplaceAllString(title, "")} {
+		if id, ok := m.byKey[bookDuplicateKey(candidate, author)]; ok {
+			return id, true
+		}
+		if ids := m.byTitle[bookDuplicateKey(candidate, "")]; len(ids) == 1 {
+			return ids[0], true
+		}
+	}
+	return 0, false
+}
+
+// mergeKindleClippings tekrar eden vurguları ayıklar ve notları ilgili vurgulara
+// bağlar. Kindle bir vurgu düzenlendiğinde aynı konum için yeni kupür ekler;
+// bu durumda en son eklenen kupür tutulur.
+func mergeKindleClippings(clippings []utils.KindleClipping) ([]utils.KindleClipping, map[int]string, []utils.KindleClipping) {
+	type locationKey struct {
+		book     string
+		location int
+	}
+
+	var highlights []utils.KindleClipping
+	var rest []utils.KindleClipping
+	positions := map[string]int{}
+	for _, clipping := range clippings {
+		if clipping.Kind != utils.KindleHighlight {
+			rest = append(rest, clipping)
+			continue
+		}
+
+		bookKey := bookDuplicateKey(clipping.Title, clipping.Author)
+		key := bookKey + "|" + clipping.Text
+		if clipping.LocationStart > 0 {
+			key = fmt.Sprintf("%s|%d", bookKey, clipping.LocationStart)
+		}
+		if i, ok := positions[key]; ok {
+			rest = append(rest, highlights[i])
+			highlights[i] = clipping
+			continue
+		}
+		positions[key] = len(highlights)
+		highlights = append(highlights, clipping)
+	}
+
+	// Notlar vurgunun bittiği konuma eklenir
+	ends := map[locationKey]int{}
+	for i, highlight := range highlights {
+		ends[locationKey{bookDuplicateKey(highlight.Title, highlight.Author), highlight.LocationEnd}] = i
+	}
+	notes := map[int]string{}
+	var unmatched []utils.KindleClipping
+	for _, clipping := range rest {
+		if clipping.Kind == utils.KindleNote {
+			if i, ok := ends[locationKey{bookDuplicateKey(clipping.Title, clipping.Author), clipping.LocationStart}]; ok && clipping.LocationStart > 0 {
+				notes[i] = clipping.Text
+				continue
+			}
+		}
+		unmatched = append(unmatched, clipping)
+	}
+	return highlights, notes, unmatched
+}
+
+// ImportKindleClippings godoc
+// @Summary      Kindle vurgularını içe aktarma
+// @Description  Kindle My Clippings.txt dosyasındaki vurgu ve notları kitaplarla eşleştirerek içe aktarır. Eşleşmeyen kitaplar oluşturulur (puanlanmamış olarak), tekrar eden kupürler atlanır.
+// @Tags         highlights
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        file  formData  file  true  "My Clippings.txt"
+// @Success      201   {object}  models.ImportJob
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/highlights/import [post]
+func ImportKindleClippings(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportFileSize)
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Dosya alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Dosya açılamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+
+	clippings, err := utils.ParseKindleClippings(file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Kupürler okunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	matcher, err := newKindleBookMatcher(userID.(uint))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kitaplar alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	job := models.ImportJob{
+		UserID:    userID.(uint),
+		Source:    "kindle",
+		Status:    models.ImportJobRunning,
+		TotalRows: len(clippings),
+		Results:   []models.ImportRowResult{},
+	}
+
+	highlights, notes, rest := mergeKindleClippings(clippings)
+	for _, clipping := range rest {
+		result := models.ImportRowResult{Row: clipping.Index, Title: clipping.Title, Author: clipping.Author}
+		switch clipping.Kind {
+		case utils.KindleHighlight:
+			result.Status = models.ImportRowDup
+			result.Message = "Aynı konumdaki daha yeni bir vurgu kullanıldı"
+		case utils.KindleNote:
+			result.Status = models.ImportRowSkipped
+			result.Message = "Notun bağlı olduğu vurgu bulunamadı"
+		default:
+			result.Status = models.ImportRowSkipped
+			result.Message = "Yer imleri içe aktarılmaz"
+		}
+		job.AddResult(result)
+	}
+
+	for i, clipping := range highlights {
+		result := models.ImportRowResult{Row: clipping.Index, Title: clipping.Title, Author: clipping.Author}
+
+		bookID, ok := matcher.match(clipping.Title, clipping.Author)
+		if !ok {
+			book, err := createKindleBook(userID.(uint), clipping)
+			if err != nil {
+				result.Status = models.ImportRowError
+				result.Message = "Kitap oluşturulamadı: " + err.Error()
+				job.AddResult(result)
+				continue
+			}
+			matcher.add(book)
+			bookID = book.ID
+			result.Message = "Kitap oluşturuldu"
+		}
+		result.BookID = bookID
+
+		highlight := models.Highlight{
+			UserID:        userID.(uint),
+			BookID:        bookID,
+			Text:          clipping.Text,
+			Location:      clipping.Location,
+			Page:          clipping.Page,
+			Note:          notes[i],
+			HighlightedAt: clipping.AddedAt,
+			Hash:          highlightHash(bookID, clipping.Location, clipping.Text),
+		}
+
+		var count int64
+		if err := config.DB.Model(&models.Highlight{}).Where("user_id = ? AND hash = ?", highlight.UserID, highlight.Hash).Count(&count).Error; err != nil {
+			result.Status = models.ImportRowError
+			result.Message = err.Error()
+			job.AddResult(result)
+			continue
+		}
+		if count > 0 {
+			result.Status = models.ImportRowDup
+			result.Message = "Bu vurgu daha önce içe aktarılmış"
+			job.AddResult(result)
+			continue
+		}
+
+		if err := config.DB.Create(&highlight).Error; err != nil {
+			result.Status = models.ImportRowError
+			result.Message = err.Error()
+			job.AddResult(result)
+			continue
+		}
+		result.Status = models.ImportRowImported
+		job.AddResult(result)
+	}
+
+	sort.Slice(job.Results, func(i, j int) bool {
+		return job.Results[i].Row < job.Results[j].Row
+	})
+
+	now := time.Now()
+	job.Status = models.ImportJobCompleted
+	job.FinishedAt = &now
+	if err := config.DB.Create(&job).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "İçe aktarma raporu kaydedilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"status":  "success",
+		"message": "Vurgular başarıyla içe aktarıldı",
+		"data":    job,
+	})
+}
+
+// createKindleBook eşleşmeyen kupür için puanlanmamış bir kitap oluşturur
+func createKindleBook(userID uint, clipping utils.KindleClipping) (models.Book, error) {
+	readDate := time.Now()
+	if clipping.AddedAt != nil {
+		readDate = *clipping.AddedAt
+	}
+	author := clipping.Author
+	if author == "" {
+		author = "Bilinmiyor"
+	}
+
+	book := models.Book{
+		UserID:   userID,
+		Title:    clipping.Title,
+		Author:   author,
+		ReadDate: readDate,
+		Tags:     []string{"kindle"},
+		Reads:    []models.BookRead{{ReadDate: readDate}},
+	}
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&book).Error; err != nil {
+			return err
+		}
+		return config.SyncBookAuthors(tx, &book)
+	})
+	return book, err
+}
+
+// GetBookHighlights godoc
+// @Summary      Kitap vurguları
+// @Description  Kitabın vurgularını listeler
+// @Tags         highlights
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Kitap ID"
+// @Success      200  {array}   models.Highlight
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/{id}/highlights [get]
+func GetBookHighlights(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	bookID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kitap ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	book, err := findUserBook(config.DB, userID, bookID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	highlights := []models.Highlight{}
+	if err := config.DB.Where("book_id = ?", book.ID).Order("highlighted_at, id").Find(&highlights).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Vurgular alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Vurgular başarıyla getirildi",
+		"data":    highlights,
+	})
+}
@@ -0,0 +1,44 @@
+package controllers
+
+import (
+	"strings"
+	"testing"
+
+	"go-api/utils"
+)
+
+func TestMergeKindleClippings(t *testing.T) {
+	input := strings.Join([]string{
+		"Dune (Frank Herbert)\n- Your Highlight on Location 100-105 | Added on Sunday, March 5, 2023 9:07:30 PM\n\nkorku zihin öldürücüdür",
+		"Dune (Frank Herbert)\n- Your Note on Location 105 | Added on Sunday, March 5, 2023 9:08:00 PM\n\nünlü söz",
+		"Dune (Frank Herbert)\n- Your Highlight on Location 200-210 | Added on Sunday, March 5, 2023 9:10:00 PM\n\nilk hali",
+		"Dune (Frank Herbert)\n- Your Highlight on Location 200-212 | Added on Sunday, March 5, 2023 9:11:00 PM\n\ndüzenlenmiş hali",
+		"Dune (Frank Herbert)\n- Your Note on Location 212 | Added on Sunday, March 5, 2023 9:12:00 PM\n\ndüzenlenmiş vurguya not",
+		"Dune Messiah (Frank Herbert)\n- Your Note on Location 105 | Added on Sunday, March 5, 2023 9:13:00 PM\n\nbaşka kitaba not",
+		"Dune (Frank Herbert)\n- Your Bookmark on Location 300 | Added on Sunday, March 5, 2023 9:14:00 PM\n\n",
+	}, "\n==========\n")
+	clippings, err := utils.ParseKindleClippings(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	highlights, notes, rest := mergeKindleClippings(clippings)
+	if len(highlights) != 2 {
+		t.Fatalf("%d vurgu, want 2", len(highlights))
+	}
+	if highlights[0].Text != "korku zihin öldürücüdür" || notes[0] != "ünlü söz" {
+		t.Errorf("ilk vurgu %q, not %q", highlights[0].Text, notes[0])
+	}
+	if highlights[1].Text != "düzenlenmiş hali" || notes[1] != "düzenlenmiş vurguya not" {
+		t.Errorf("düzenlenen vurgu %q, not %q", highlights[1].Text, notes[1])
+	}
+
+	var restTexts []string
+	for _, clipping := range rest {
+		restTexts = append(restTexts, clipping.Kind+":"+clipping.Text)
+	}
+	want := []string{"highlight:ilk hali", "note:başka kitaba not", "bookmark:"}
+	if strings.Join(restTexts, "|") != strings.Join(want, "|") {
+		t.Errorf("kalan kupürler %q, want %q", restTexts, want)
+	}
+}
@@ -0,0 +1,497 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-api/config"
+	"go-api/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// newLoanResponse ödünç kaydını gecikme durumu ve karşı taraftaki kullanıcıyla
+// döner. loan.Contact yüklenmiş olmalıdır.
+func newLoanResponse(loan models.Loan, now time.Time) models.LoanResponse {
+	response := models.LoanResponse{Loan: loan, Overdue: loan.IsOverdue(now)}
+	if loan.Contact != nil {
+		contact := newPublicUser(*loan.Contact)
+		response.Contact = &contact
+	}
+	return response
+}
+
+// findLoan :id parametresindeki ödünç kaydını kullanıcının kayıtları arasında
+// bulur; bulunamazsa hata yanıtını yazar ve false döner
+func findLoan(c *gin.Context, userID interface{}) (models.Loan, bool) {
+	var loan models.Loan
+
+	loanID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz ödünç kaydı ID",
+			"error":   err.Error(),
+		})
+		return loan, false
+	}
+
+	if err := config.DB.Preload("Contact").Where("id = ? AND user_id = ?", loanID, userID).First(&loan).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Ödünç kaydı bulunamadı",
+			"error":   err.Error(),
+		})
+		return loan, false
+	}
+	return loan, true
+}
+
+// validateLoanDates iade tarihinin ödünç tarihinden önce olmadığını kontrol eder
+func validateLoanDates(loanedAt time.Time, dueDate *time.Time) error {
+	if dueDate != nil && dueDate.Before(loanedAt) {
+		return errors.New("due_date, loaned_at tarihinden önce olamaz")
+	}
+	return nil
+}
+
+// GetLoans godoc
+// @Summary      Ödünç kayıtları
+// @Description  Kullanıcının ödünç verdiği ve ödünç aldığı kitapları listeler. overdue=true iade tarihi geçmiş ve iade edilmemiş kayıtları döner.
+// @Tags         loans
+// @Accept       json
+// @Produce      json
+// @Param        direction  query     string  false  "lent veya borrowed"
+// @Param        status     query     string  false  "active (iade edilmemiş) veya returned"
+// @Param        overdue    query     bool    false  "Yalnızca gecikmiş kayıtlar"
+// @Success      200        {array}   models.LoanResponse
+// @Failure      400        {object}  map[string]interface{}
+// @Failure      401        {object}  map[string]interface{}
+// @Failure      500        {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/loans [get]
+func GetLoans(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	now := time.Now()
+	query := config.DB.Preload("Contact").Where("user_id = ?", userID)
+	switch direction := c.Query("direction"); direction {
+	case "":
+	case models.LoanLent, models.LoanBorrowed:
+		query = query.Where("direction = ?", direction)
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz filtre",
+			"error":   fmt.Sprintf("geçersiz direction: %q", direction),
+		})
+		return
+	}
+	switch status := c.Query("status"); status {
+	case "":
+	case "active":
+		query = query.Where("returned_at IS NULL")
+	case "returned":
+		query = query.Where("returned_at IS NOT NULL")
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz filtre",
+			"error":   fmt.Sprintf("geçersiz status: %q", status),
+		})
+		return
+	}
+	if c.Query("overdue") == "true" {
+		query = query.Where("returned_at IS NULL AND due_date IS NOT NULL AND due_date < ?", now)
+	}
+
+	var loans []models.Loan
+	if err := query.Order("returned_at IS NOT NULL, due_date IS NULL, due_date, loaned_at DESC, id DESC").Find(&loans).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Ödünç kayıtları alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	response := make([]models.LoanResponse, 0, len(loans))
+	for _, loan := range loans {
+		response = append(response, newLoanResponse(loan, now))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Ödünç kayıtları başarıyla getirildi",
+		"data":    response,
+	})
+}
+
+// CreateLoan godoc
+// @Summary      Ödünç kaydı ekleme
+// @Description  Kütüphanedeki bir kitabın ödünç verildiğini (lent) veya başkasından kitap ödünç alındığını (borrowed) kaydeder. Karşı taraf uygulamadaki bir kullanıcı (username) veya bir isimdir (name). Henüz iade edilmemiş bir kitap tekrar ödünç verilemez.
+// @Tags         loans
+// @Accept       json
+// @Produce      json
+// @Param        loan  body      models.LoanRequest  true  "Ödünç bilgileri"
+// @Success      201   {object}  models.LoanResponse
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      404   {object}  map[string]interface{}
+// @Failure      409   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/loans [post]
+func CreateLoan(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	var request models.LoanRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	loan := models.Loan{
+		UserID:    userID.(uint),
+		Direction: request.Direction,
+		Name:      strings.TrimSpace(request.Name),
+		LoanedAt:  time.Now(),
+		DueDate:   request.DueDate,
+		Notes:     request.Notes,
+	}
+	if request.LoanedAt != nil {
+		loan.LoanedAt = *request.LoanedAt
+	}
+
+	var validationErr error
+	switch {
+	case request.Username == "" && loan.Name == "":
+		validationErr = errors.New("username veya name zorunludur")
+	case request.BookID == nil && request.Direction == models.LoanLent:
+		validationErr = errors.New("ödünç verilen kitap için book_id zorunludur")
+	case request.BookID == nil && (strings.TrimSpace(request.Title) == "" || strings.TrimSpace(request.Author) == ""):
+		validationErr = errors.New("kütüphanede olmayan kitap için title ve author zorunludur")
+	default:
+		validationErr = validateLoanDates(loan.LoanedAt, loan.DueDate)
+	}
+	if validationErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   validationErr.Error(),
+		})
+		return
+	}
+
+	if request.Username != "" {
+		var contact models.User
+		if err := config.DB.Where("username = ?", request.Username).First(&contact).Error; err != nil {
+			c.JSON(http.StatusNotFound, gin.H{
+				"status":  "error",
+				"message": "Kullanıcı bulunamadı",
+				"error":   err.Error(),
+			})
+			return
+		}
+		if contact.ID == loan.UserID {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"status":  "error",
+				"message": "Geçersiz istek",
+				"error":   "kullanıcı kendisiyle ödünç kaydı oluşturamaz",
+			})
+			return
+		}
+		loan.ContactID = &contact.ID
+		loan.Contact = &contact
+	}
+
+	if request.BookID != nil {
+		book, err := findUserBook(config.DB, userID, uint64(*request.BookID))
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{
+				"status":  "error",
+				"message": "Kitap bulunamadı",
+				"error":   err.Error(),
+			})
+			return
+		}
+		loan.BookID = &book.ID
+		loan.Title = book.Title
+		loan.Author = book.Author
+	} else {
+		loan.Title = strings.TrimSpace(request.Title)
+		loan.Author = strings.TrimSpace(request.Author)
+	}
+
+	if loan.Direction == models.LoanLent {
+		var active int64
+		err := config.DB.Model(&models.Loan{}).
+			Where("book_id = ? AND direction = ? AND returned_at IS NULL", loan.BookID, models.LoanLent).
+			Count(&active).Error
+		if err == nil && active > 0 {
+			c.JSON(http.StatusConflict, gin.H{
+				"status":  "error",
+				"message": "Kitap zaten ödünç verilmiş",
+				"error":   "kitap iade edilmeden tekrar ödünç verilemez",
+			})
+			return
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"status":  "error",
+				"message": "Ödünç kaydı eklenemedi",
+				"error":   err.Error(),
+			})
+			return
+		}
+	}
+
+	if err := config.DB.Omit("Contact").Create(&loan).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Ödünç kaydı eklenemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"status":  "success",
+		"message": "Ödünç kaydı başarıyla eklendi",
+		"data":    newLoanResponse(loan, time.Now()),
+	})
+}
+
+// GetLoan godoc
+// @Summary      Ödünç kaydı detayı
+// @Description  Ödünç kaydını getirir
+// @Tags         loans
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Ödünç kaydı ID"
+// @Success      200  {object}  models.LoanResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/loans/{id} [get]
+func GetLoan(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	loan, ok := findLoan(c, userID)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Ödünç kaydı başarıyla getirildi",
+		"data":    newLoanResponse(loan, time.Now()),
+	})
+}
+
+// UpdateLoan godoc
+// @Summary      Ödünç kaydı güncelleme
+// @Description  Ödünç kaydının karşı taraf adını, tarihlerini ve notlarını günceller. İade tarihi değişirse hatırlatmalar yeni tarihe göre yeniden gönderilir.
+// @Tags         loans
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int                       true  "Ödünç kaydı ID"
+// @Param        loan  body      models.LoanUpdateRequest  true  "Ödünç bilgileri"
+// @Success      200   {object}  models.LoanResponse
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      404   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/loans/{id} [put]
+func UpdateLoan(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	loan, ok := findLoan(c, userID)
+	if !ok {
+		return
+	}
+
+	var request models.LoanUpdateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+	name := strings.TrimSpace(request.Name)
+	validationErr := validateLoanDates(request.LoanedAt, request.DueDate)
+	if validationErr == nil && name == "" && loan.ContactID == nil {
+		validationErr = errors.New("name zorunludur")
+	}
+	if validationErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   validationErr.Error(),
+		})
+		return
+	}
+
+	dueChanged := (loan.DueDate == nil) != (request.DueDate == nil) ||
+		(loan.DueDate != nil && !loan.DueDate.Equal(*request.DueDate))
+	if dueChanged {
+		loan.ReminderStage = models.LoanReminderNone
+	}
+	loan.Name = name
+	loan.LoanedAt = request.LoanedAt
+	loan.DueDate = request.DueDate
+	loan.Notes = request.Notes
+	if err := config.DB.Omit("Contact").Save(&loan).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Ödünç kaydı güncellenemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Ödünç kaydı başarıyla güncellendi",
+		"data":    newLoanResponse(loan, time.Now()),
+	})
+}
+
+// ReturnLoan godoc
+// @Summary      İade kaydetme
+// @Description  Ödünç kitabın iade edildiğini kaydeder; zaten iade edilmişse iade zamanı değişmez
+// @Tags         loans
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Ödünç kaydı ID"
+// @Success      200  {object}  models.LoanResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/loans/{id}/return [post]
+func ReturnLoan(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	loan, ok := findLoan(c, userID)
+	if !ok {
+		return
+	}
+
+	if loan.ReturnedAt == nil {
+		now := time.Now()
+		loan.ReturnedAt = &now
+		if err := config.DB.Model(&loan).Update("returned_at", now).Error; err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"status":  "error",
+				"message": "İade kaydedilemedi",
+				"error":   err.Error(),
+			})
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "İade kaydedildi",
+		"data":    newLoanResponse(loan, time.Now()),
+	})
+}
+
+// DeleteLoan godoc
+// @Summary      Ödünç kaydı silme
+// @Description  Ödünç kaydını siler
+// @Tags         loans
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Ödünç kaydı ID"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/loans/{id} [delete]
+func DeleteLoan(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	loan, ok := findLoan(c, userID)
+	if !ok {
+		return
+	}
+
+	if err := config.DB.Delete(&loan).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Ödünç kaydı silinemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Ödünç kaydı başarıyla silindi",
+	})
+}
@@ -0,0 +1,473 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go-api/config"
+	"go-api/models"
+	"go-api/utils"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// Bildirim listesi sayfa boyutu
+const (
+	defaultNotificationLimit = 20
+	maxNotificationLimit     = 100
+)
+
+// goalMilestones bildirim gönderilen hedef yüzdeleri, küçükten büyüğe
+var goalMilestones = []int{25, 50, 75, 100}
+
+// notifyBookOwner kitabın sahibine, actorID kullanıcısının kitapla ilgili
+// etkinliği için bildirim yayınlar. Kullanıcının kendi kitabındaki
+// etkinlikleri bildirilmez.
+func notifyBookOwner(tx *gorm.DB, book models.Book, actorID uint, notificationType string, commentID *uint) error {
+	if book.UserID == actorID {
+		return nil
+	}
+
+	var actor models.User
+	if err := tx.First(&actor, actorID).Error; err != nil {
+		return err
+	}
+
+	message := actor.Username + " kitabınızı beğendi: " + book.Title
+	if notificationType == models.NotificationBookCommented {
+		message = actor.Username + " kitabınıza yorum yaptı: " + book.Title
+	}
+
+	return config.PublishNotification(tx, models.Notification{
+		UserID:    book.UserID,
+		Type:      notificationType,
+		ActorID:   &actorID,
+		BookID:    &book.ID,
+		CommentID: commentID,
+		Message:   message,
+	})
+}
+
+// notifyFollow takip edilen kullanıcıya yeni takipçi veya takip isteği
+// bildirimi, takip isteği onaylandığında takip edene bildirim yayınlar
+func notifyFollow(tx *gorm.DB, follow models.Follow, notificationType string) error {
+	recipientID, actorID := follow.FolloweeID, follow.FollowerID
+	if notificationType == models.NotificationFollowAccepted {
+		recipientID, actorID = follow.FollowerID, follow.FolloweeID
+	}
+
+	var actor models.User
+	if err := tx.First(&actor, actorID).Error; err != nil {
+		return err
+	}
+
+	message := actor.Username + " sizi takip etmeye başladı"
+	switch notificationType {
+	case models.NotificationFollowRequested:
+		message = actor.Username + " sizi takip etmek istiyor"
+	case models.NotificationFollowAccepted:
+		message = actor.Username + " takip isteğinizi onayladı"
+	}
+
+	return config.PublishNotification(tx, models.Notification{
+		UserID:  recipientID,
+		Type:    notificationType,
+		ActorID: &actorID,
+		Message: message,
+	})
+}
+
+// notifyGoalMilestone okuma eklendikten sonra yılın kitap hedefinde yeni bir
+// eşiğe (%25, %50, %75, %100) ulaşıldıysa bildirim yayınlar. Kitap hedefi
+// yoksa sayfa hedefi kullanılır. Hata okuma işlemini etkilemez, yalnızca loglanır.
+func notifyGoalMilestone(userID uint, year int) {
+	goal, _, err := loadYearGoal(userID, year)
+	if err == nil && goal != nil {
+		err = publishGoalMilestone(goal)
+	}
+	if err != nil {
+		log.Printf("Hedef bildirimi gönderilemedi (kullanıcı %d, %d): %v", userID, year, err)
+	}
+}
+
+// publishGoalMilestone hedefin ulaşılan en yüksek eşiği bildirilmemişse bildirir
+func publishGoalMilestone(goal *models.ReadingGoal) error {
+	progress, err := yearGoalProgress(goal.UserID, goal.Year, goal, nil)
+	if err != nil {
+		return err
+	}
+	pacing, unit := progress.Books, "kitap"
+	if pacing == nil {
+		pacing, unit = progress.Pages, "sayfa"
+	}
+	if pacing == nil {
+		return nil
+	}
+
+	milestone := 0
+	for _, percent := range goalMilestones {
+		if pacing.Percent >= float64(percent) {
+			milestone = percent
+		}
+	}
+	if milestone <= goal.NotifiedMilestone {
+		return nil
+	}
+
+	message := fmt.Sprintf("%d okuma hedefinizde %%%d ilerlemeye ulaştınız (%d/%d %s)", goal.Year, milestone, pacing.Read, pacing.Target, unit)
+	if milestone == 100 {
+		message = fmt.Sprintf("%d okuma hedefinizi tamamladınız (%d/%d %s)", goal.Year, pacing.Read, pacing.Target, unit)
+	}
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		// Eşik koşullu güncellenir; eşzamanlı okumalar aynı bildirimi iki kez yayınlamaz
+		result := tx.Model(&models.ReadingGoal{}).
+			Where("id = ? AND notified_milestone < ?", goal.ID, milestone).
+			Update("notified_milestone", milestone)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return config.PublishNotification(tx, models.Notification{
+			UserID:  goal.UserID,
+			Type:    models.NotificationGoalMilestone,
+			Message: message,
+		})
+	})
+}
+
+// notifyImportFinished arka planda çalışan içe aktarma işi bittiğinde kullanıcıya bildirim yayınlar
+func notifyImportFinished(job models.ImportJob) error {
+	message := fmt.Sprintf("İçe aktarma tamamlandı: %d kitap eklendi, %d kopya, %d atlandı, %d hatalı", job.Imported, job.Duplicates, job.Skipped, job.Failed)
+	if job.Status == models.ImportJobFailed {
+		message = "İçe aktarma başarısız oldu: " + job.Error
+	}
+	return config.PublishNotification(config.DB, models.Notification{
+		UserID:  job.UserID,
+		Type:    models.NotificationImportFinished,
+		Message: message,
+	})
+}
+
+// GetNotifications godoc
+// @Summary      Bildirimler
+// @Description  Kullanıcının uygulama içi bildirimlerini yeniden eskiye listeler. Sonraki sayfa için yanıttaki next_cursor değeri cursor parametresine verilir.
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Param        unread  query     bool  false  "Yalnızca okunmamışlar"
+// @Param        cursor  query     int   false  "Önceki sayfanın next_cursor değeri"
+// @Param        limit   query     int   false  "Sayfa boyutu (varsayılan 20, en fazla 100)"
+// @Success      200     {object}  models.NotificationListResponse
+// @Failure      400     {object}  map[string]interface{}
+// @Failure      401     {object}  map[string]interface{}
+// @Failure      500     {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/notifications [get]
+func GetNotifications(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	limit := defaultNotificationLimit
+	if value := c.Query("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > maxNotificationLimit {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"status":  "error",
+				"message": "Geçersiz istek",
+				"error":   "limit 1 ile 100 arasında olmalıdır",
+			})
+			return
+		}
+		limit = parsed
+	}
+
+	scope := config.DB.Model(&models.Notification{}).Where("user_id = ? AND NOT hidden_in_app", userID)
+	query := scope.Session(&gorm.Session{})
+	if c.Query("unread") == "true" {
+		query = query.Where("read_at IS NULL")
+	}
+	if value := c.Query("cursor"); value != "" {
+		cursor, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"status":  "error",
+				"message": "Geçersiz istek",
+				"error":   "cursor geçersiz",
+			})
+			return
+		}
+		query = query.Where("id < ?", cursor)
+	}
+
+	// Sonraki sayfanın olup olmadığını anlamak için bir fazla kayıt alınır
+	var notifications []models.Notification
+	response := models.NotificationListResponse{}
+	err := query.Preload("Actor").Order("id DESC").Limit(limit + 1).Find(&notifications).Error
+	if err == nil {
+		err = scope.Session(&gorm.Session{}).Where("read_at IS NULL").Count(&response.UnreadCount).Error
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Bildirimler alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if len(notifications) > limit {
+		notifications = notifications[:limit]
+		response.NextCursor = strconv.FormatUint(uint64(notifications[limit-1].ID), 10)
+	}
+	response.Items = make([]models.NotificationResponse, 0, len(notifications))
+	for _, notification := range notifications {
+		item := models.NotificationResponse{Notification: notification}
+		if notification.Actor != nil {
+			actor := newPublicUser(*notification.Actor)
+			item.Actor = &actor
+		}
+		response.Items = append(response.Items, item)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Bildirimler başarıyla getirildi",
+		"data":    response,
+	})
+}
+
+// MarkNotificationRead godoc
+// @Summary      Bildirimi okundu işaretleme
+// @Description  Bildirimi okundu olarak işaretler; zaten okunmuşsa okunma zamanı değişmez
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Bildirim ID"
+// @Success      200  {object}  models.Notification
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/notifications/{id}/read [post]
+func MarkNotificationRead(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	notificationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz bildirim ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var notification models.Notification
+	err = config.DB.Where("id = ? AND user_id = ? AND NOT hidden_in_app", notificationID, userID).First(&notification).Error
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Bildirim bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if notification.ReadAt == nil {
+		now := time.Now()
+		notification.ReadAt = &now
+		if err := config.DB.Model(&notification).Update("read_at", now).Error; err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"status":  "error",
+				"message": "Bildirim güncellenemedi",
+				"error":   err.Error(),
+			})
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Bildirim okundu olarak işaretlendi",
+		"data":    notification,
+	})
+}
+
+// MarkAllNotificationsRead godoc
+// @Summary      Tüm bildirimleri okundu işaretleme
+// @Description  Kullanıcının okunmamış tüm bildirimlerini okundu olarak işaretler ve işaretlenen sayıyı döner
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/notifications/read-all [post]
+func MarkAllNotificationsRead(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	result := config.DB.Model(&models.Notification{}).
+		Where("user_id = ? AND NOT hidden_in_app AND read_at IS NULL", userID).
+		Update("read_at", time.Now())
+	if result.Error != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Bildirimler güncellenemedi",
+			"error":   result.Error.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Tüm bildirimler okundu olarak işaretlendi",
+		"data":    gin.H{"updated": result.RowsAffected},
+	})
+}
+
+// GetNotificationPreferences godoc
+// @Summary      Bildirim tercihleri
+// @Description  Kullanıcının bildirim kanallarını ve kapattığı bildirim türlerini getirir
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  models.NotificationPreference
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/notifications/preferences [get]
+func GetNotificationPreferences(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	preference, err := config.UserNotificationPreference(userID.(uint))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Bildirim tercihleri alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Bildirim tercihleri başarıyla getirildi",
+		"data":    preference,
+	})
+}
+
+// UpdateNotificationPreferences godoc
+// @Summary      Bildirim tercihlerini güncelleme
+// @Description  Uygulama içi, e-posta ve webhook kanallarını açıp kapatır ve bildirim türlerini kapatır. Webhook açıksa webhook_url zorunludur; adres https olmalı ve herkese açık bir sunucuya çözülmelidir. Bildirimler JSON olarak POST edilir, yönlendirmeler izlenmez.
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Param        preferences  body      models.NotificationPreferenceRequest  true  "Bildirim tercihleri"
+// @Success      200          {object}  models.NotificationPreference
+// @Failure      400          {object}  map[string]interface{}
+// @Failure      401          {object}  map[string]interface{}
+// @Failure      500          {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/notifications/preferences [put]
+func UpdateNotificationPreferences(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	var request models.NotificationPreferenceRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if request.Webhook && request.WebhookURL == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   "webhook açıkken webhook_url zorunludur",
+		})
+		return
+	}
+	if request.WebhookURL != "" {
+		if err := utils.ValidateWebhookURL(c.Request.Context(), request.WebhookURL); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"status":  "error",
+				"message": "Geçersiz webhook adresi",
+				"error":   err.Error(),
+			})
+			return
+		}
+	}
+
+	preference, err := config.UserNotificationPreference(userID.(uint))
+	if err == nil {
+		preference.InApp = request.InApp
+		preference.Email = request.Email
+		preference.Webhook = request.Webhook
+		preference.WebhookURL = request.WebhookURL
+		preference.MutedTypes = request.MutedTypes
+		if preference.MutedTypes == nil {
+			preference.MutedTypes = []string{}
+		}
+		err = config.DB.Save(&preference).Error
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Bildirim tercihleri kaydedilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Bildirim tercihleri kaydedildi",
+		"data":    preference,
+	})
+}
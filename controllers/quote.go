@@ -0,0 +1,494 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-api/config"
+	"go-api/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// applyQuoteRequest istekteki alanları alıntıya yazar; boş etiketler çıkarılır.
+// Yalnızca boşluktan oluşan alıntı metni hata döner.
+func applyQuoteRequest(quote *models.Quote, request models.QuoteRequest) error {
+	text := strings.TrimSpace(request.Text)
+	if text == "" {
+		return errors.New("alıntı metni boş olamaz")
+	}
+	quote.Text = text
+	quote.Page = request.Page
+	quote.Favorite = request.Favorite
+	quote.Tags = []string{}
+	for _, tag := range request.Tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			quote.Tags = append(quote.Tags, tag)
+		}
+	}
+	return nil
+}
+
+// quoteResponseColumns alıntıları kitap adı ve yazarıyla okuyan kolonlar
+const quoteResponseColumns = "quotes.*, books.title AS book_title, books.author AS book_author"
+
+// userQuotes kullanıcının çöp kutusunda olmayan kitaplarındaki alıntıları seçen sorguyu döner
+func userQuotes(userID interface{}) *gorm.DB {
+	return config.DB.Table("quotes").
+		Joins("JOIN books ON books.id = quotes.book_id AND books.deleted_at IS NULL").
+		Where("quotes.user_id = ?", userID)
+}
+
+// findBookQuote kitabın alıntısını yol parametrelerinden bulur. Hata durumunda
+// yanıtı yazar ve false döner.
+func findBookQuote(c *gin.Context, userID interface{}) (models.Quote, bool) {
+	var quote models.Quote
+
+	bookID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kitap ID",
+			"error":   err.Error(),
+		})
+		return quote, false
+	}
+
+	quoteID, err := strconv.ParseUint(c.Param("quoteId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz alıntı ID",
+			"error":   err.Error(),
+		})
+		return quote, false
+	}
+
+	book, err := findUserBook(config.DB, userID, bookID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return quote, false
+	}
+
+	if err := config.DB.Where("id = ? AND book_id = ?", quoteID, book.ID).First(&quote).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Alıntı bulunamadı",
+			"error":   err.Error(),
+		})
+		return quote, false
+	}
+	return quote, true
+}
+
+// GetBookQuotes godoc
+// @Summary      Kitap alıntıları
+// @Description  Kitabın alıntılarını sayfa sırasıyla listeler
+// @Tags         quotes
+// @Accept       json
+// @Produce      json
+// @Param        id        path      int   true   "Kitap ID"
+// @Param        favorite  query     bool  false  "Yalnızca favoriler"
+// @Success      200       {array}   models.Quote
+// @Failure      400       {object}  map[string]interface{}
+// @Failure      401       {object}  map[string]interface{}
+// @Failure      404       {object}  map[string]interface{}
+// @Failure      500       {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/{id}/quotes [get]
+func GetBookQuotes(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	bookID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kitap ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	book, err := findUserBook(config.DB, userID, bookID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	query := config.DB.Where("book_id = ?", book.ID)
+	if c.Query("favorite") == "true" {
+		query = query.Where("favorite = ?", true)
+	}
+
+	quotes := []models.Quote{}
+	if err := query.Order("page IS NULL, page, id").Find(&quotes).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Alıntılar alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Alıntılar başarıyla getirildi",
+		"data":    quotes,
+	})
+}
+
+// CreateBookQuote godoc
+// @Summary      Alıntı ekleme
+// @Description  Kitaba yeni bir alıntı ekler
+// @Tags         quotes
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                  true  "Kitap ID"
+// @Param        quote  body      models.QuoteRequest  true  "Alıntı bilgileri"
+// @Success      201    {object}  models.Quote
+// @Failure      400    {object}  map[string]interface{}
+// @Failure      401    {object}  map[string]interface{}
+// @Failure      404    {object}  map[string]interface{}
+// @Failure      500    {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/{id}/quotes [post]
+func CreateBookQuote(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	bookID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kitap ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	book, err := findUserBook(config.DB, userID, bookID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	var request models.QuoteRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	quote := models.Quote{UserID: book.UserID, BookID: book.ID}
+	if err := applyQuoteRequest(&quote, request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if err := config.DB.Create(&quote).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Alıntı eklenemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"status":  "success",
+		"message": "Alıntı başarıyla eklendi",
+		"data":    quote,
+	})
+}
+
+// UpdateBookQuote godoc
+// @Summary      Alıntı güncelleme
+// @Description  Kitabın bir alıntısını günceller
+// @Tags         quotes
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                  true  "Kitap ID"
+// @Param        quoteId  path      int                  true  "Alıntı ID"
+// @Param        quote    body      models.QuoteRequest  true  "Alıntı bilgileri"
+// @Success      200      {object}  models.Quote
+// @Failure      400      {object}  map[string]interface{}
+// @Failure      401      {object}  map[string]interface{}
+// @Failure      404      {object}  map[string]interface{}
+// @Failure      500      {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/{id}/quotes/{quoteId} [put]
+func UpdateBookQuote(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	quote, ok := findBookQuote(c, userID)
+	if !ok {
+		return
+	}
+
+	var request models.QuoteRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if err := applyQuoteRequest(&quote, request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if err := config.DB.Save(&quote).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Alıntı güncellenemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Alıntı başarıyla güncellendi",
+		"data":    quote,
+	})
+}
+
+// DeleteBookQuote godoc
+// @Summary      Alıntı silme
+// @Description  Kitabın bir alıntısını siler
+// @Tags         quotes
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int  true  "Kitap ID"
+// @Param        quoteId  path      int  true  "Alıntı ID"
+// @Success      200      {object}  map[string]interface{}
+// @Failure      400      {object}  map[string]interface{}
+// @Failure      401      {object}  map[string]interface{}
+// @Failure      404      {object}  map[string]interface{}
+// @Failure      500      {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/books/{id}/quotes/{quoteId} [delete]
+func DeleteBookQuote(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	quote, ok := findBookQuote(c, userID)
+	if !ok {
+		return
+	}
+
+	if err := config.DB.Delete(&quote).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Alıntı silinemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Alıntı başarıyla silindi",
+	})
+}
+
+// GetQuotes godoc
+// @Summary      Alıntılarda arama
+// @Description  Kullanıcının tüm kitaplarındaki alıntıları listeler. q alıntı metninde, kitap adında ve yazarında arar. Çöp kutusundaki kitapların alıntıları listelenmez.
+// @Tags         quotes
+// @Accept       json
+// @Produce      json
+// @Param        q         query     string  false  "Alıntı metni, kitap adı veya yazarda arama"
+// @Param        tag       query     string  false  "Etiket"
+// @Param        book_id   query     int     false  "Kitap ID"
+// @Param        favorite  query     bool    false  "Yalnızca favoriler"
+// @Success      200       {array}   models.QuoteResponse
+// @Failure      400       {object}  map[string]interface{}
+// @Failure      401       {object}  map[string]interface{}
+// @Failure      500       {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/quotes [get]
+func GetQuotes(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	query := userQuotes(userID)
+	if q := strings.TrimSpace(c.Query("q")); q != "" {
+		pattern := containsPattern(q)
+		query = query.Where(`(quotes.text LIKE ? ESCAPE '\' OR books.title LIKE ? ESCAPE '\' OR books.author LIKE ? ESCAPE '\')`, pattern, pattern, pattern)
+	}
+	if tag := c.Query("tag"); tag != "" {
+		// Etiketler JSON dizisi olarak saklanır
+		condition, pattern := tagCondition("quotes.tags", tag)
+		query = query.Where(condition, pattern)
+	}
+	if value := c.Query("book_id"); value != "" {
+		bookID, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"status":  "error",
+				"message": "Geçersiz filtre",
+				"error":   fmt.Sprintf("geçersiz book_id: %q", value),
+			})
+			return
+		}
+		query = query.Where("quotes.book_id = ?", bookID)
+	}
+	if c.Query("favorite") == "true" {
+		query = query.Where("quotes.favorite = ?", true)
+	}
+
+	quotes := []models.QuoteResponse{}
+	if err := query.Select(quoteResponseColumns).Order("quotes.created_at DESC, quotes.id DESC").Scan(&quotes).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Alıntılar alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Alıntılar başarıyla getirildi",
+		"data":    quotes,
+	})
+}
+
+// GetDailyQuote godoc
+// @Summary      Günün alıntısı
+// @Description  Kullanıcının alıntılarından her gün (UTC) değişen, gün içinde sabit kalan bir alıntı seçer
+// @Tags         quotes
+// @Accept       json
+// @Produce      json
+// @Param        favorite  query     bool  false  "Yalnızca favorilerden seç"
+// @Success      200       {object}  models.QuoteResponse
+// @Failure      401       {object}  map[string]interface{}
+// @Failure      404       {object}  map[string]interface{}
+// @Failure      500       {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/quotes/daily [get]
+func GetDailyQuote(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	query := userQuotes(userID)
+	if c.Query("favorite") == "true" {
+		query = query.Where("quotes.favorite = ?", true)
+	}
+
+	var count int64
+	if err := query.Session(&gorm.Session{}).Count(&count).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Alıntı alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if count == 0 {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Alıntı bulunamadı",
+			"error":   "henüz alıntı eklenmemiş",
+		})
+		return
+	}
+
+	// Kullanıcı ve gün aynı kaldıkça aynı alıntı seçilir
+	hash := fnv.New64a()
+	fmt.Fprintf(hash, "%v:%s", userID, time.Now().UTC().Format(filterDateLayout))
+	offset := int(hash.Sum64() % uint64(count))
+
+	var quotes []models.QuoteResponse
+	if err := query.Select(quoteResponseColumns).Order("quotes.id").Offset(offset).Limit(1).Scan(&quotes).Error; err != nil || len(quotes) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Alıntı alınamadı",
+			"error":   "alıntı okunamadı",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Günün alıntısı başarıyla getirildi",
+		"data":    quotes[0],
+	})
+}
@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"reflect"
+	"testing"
+
+	"go-api/models"
+)
+
+func TestApplyQuoteRequest(t *testing.T) {
+	for _, text := range []string{"", " ", "\t\n ", "\u00a0"} {
+		quote := models.Quote{Text: "eski"}
+		if err := applyQuoteRequest(&quote, models.QuoteRequest{Text: text}); err == nil {
+			t.Errorf("applyQuoteRequest(%q) hata bekleniyordu", text)
+		}
+		if quote.Text != "eski" {
+			t.Errorf("applyQuoteRequest(%q) geçersiz istekte alıntıyı değiştirdi: %q", text, quote.Text)
+		}
+	}
+
+	page := 12
+	var quote models.Quote
+	request := models.QuoteRequest{Text: "  metin \n", Page: &page, Tags: []string{" a ", "", "  ", "b"}, Favorite: true}
+	if err := applyQuoteRequest(&quote, request); err != nil {
+		t.Fatal(err)
+	}
+	if quote.Text != "metin" || quote.Page != &page || !quote.Favorite {
+		t.Errorf("alıntı %+v", quote)
+	}
+	if want := []string{"a", "b"}; !reflect.DeepEqual(quote.Tags, want) {
+		t.Errorf("etiketler %q, want %q", quote.Tags, want)
+	}
+}
@@ -0,0 +1,269 @@
+package controllers
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go-api/config"
+	"go-api/models"
+	"go-api/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Öneri listesi boyutu
+const (
+	defaultRecommendationLimit = 20
+	maxRecommendationLimit     = 100
+)
+
+// Diğer kullanıcıların kitaplarından değerlendirilen en fazla kitap ve
+// adayları seçmekte kullanılan en fazla etiket sayısı
+const (
+	recommendationPoolSize = 2000
+	recommendationMaxTags  = 50
+)
+
+// recommendationBook öneri hesabı için diğer kullanıcıların kitaplarından
+// okunan sütunlar
+type recommendationBook struct {
+	ID       uint
+	UserID   uint
+	Title    string
+	Author   string
+	Tags     []string `gorm:"serializer:json"`
+	Rating   int
+	ISBN13   string
+	Username string
+}
+
+// topTags kitaplardaki en sık etiketleri döner; eşit sıklıktakiler alfabetik sıralanır
+func topTags(books []models.Book, limit int) []string {
+	counts := map[string]int{}
+	for _, book := range books {
+		for _, tag := range book.Tags {
+			if tag = strings.ToLower(strings.TrimSpace(tag)); tag != "" {
+				counts[tag]++
+			}
+		}
+	}
+	tags := make([]string, 0, len(counts))
+	for tag := range counts {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if counts[tags[i]] != counts[tags[j]] {
+			return counts[tags[i]] > counts[tags[j]]
+		}
+		return tags[i] < tags[j]
+	})
+	if len(tags) > limit {
+		tags = tags[:limit]
+	}
+	return tags
+}
+
+// findRecommendationBooks diğer kullanıcıların puanlanmış public kitaplarından
+// kullanıcının kütüphanesiyle yazar veya etiket paylaşanları döner. Aynı
+// kitabın diğer kopyaları da yazarı paylaştığından benzerlik hesabı için
+// yeterlidir. Private hesapların kitapları yalnızca onaylı takipçilerine
+// önerilir; sonuç en yeni recommendationPoolSize kitapla sınırlıdır.
+func findRecommendationBooks(userID interface{}, ownBooks []models.Book) ([]recommendationBook, error) {
+	authorBooks := config.DB.Table("book_authors").
+		Select("book_authors.book_id").
+		Joins("JOIN authors ON authors.id = book_authors.author_id").
+		Where("authors.name_key IN (?)", config.DB.Model(&models.Author{}).Select("name_key").Where("user_id = ?", userID))
+	related := config.DB.Where("books.id IN (?)", authorBooks)
+	for _, tag := range topTags(ownBooks, recommendationMaxTags) {
+		condition, pattern := tagCondition("books.tags", tag)
+		related = related.Or(condition, pattern)
+	}
+
+	followees := config.DB.Model(&models.Follow{}).Select("followee_id").
+		Where("follower_id = ? AND status = ?", userID, models.FollowAccepted)
+
+	books := []recommendationBook{}
+	err := config.DB.Table("books").
+		Select("books.id, books.user_id, books.title, books.author, books.tags, books.rating, books.isbn13, users.username").
+		Joins("JOIN users ON users.id = books.user_id AND users.deleted_at IS NULL").
+		Where("books.deleted_at IS NULL AND books.user_id <> ? AND books.visibility = ? AND books.rating > 0", userID, models.VisibilityPublic).
+		Where("users.private_account = ? OR users.id IN (?)", false, followees).
+		Where(related).
+		Order("books.id DESC").
+		Limit(recommendationPoolSize).
+		Find(&books).Error
+	return books, err
+}
+
+// recommendationMinRating diğer kullanıcıların kitaplarından önerilmek için gereken en düşük puan
+const recommendationMinRating = 4
+
+// wishlistPriorityBoost istek listesindeki önceliğin öneri puanına katkısı
+var wishlistPriorityBoost = map[string]float64{
+	models.WishlistPriorityHigh:   0.5,
+	models.WishlistPriorityMedium: 0.25,
+	models.WishlistPriorityLow:    0,
+}
+
+// GetRecommendations godoc
+// @Summary      Kitap önerileri
+// @Description  Kullanıcının istek listesinden ve diğer kullanıcıların yüksek puanlı herkese açık kitaplarından öneriler sunar. Puan; kullanıcının yüksek puan verdiği yazar ve etiketlerle örtüşmeye ve puanlama alışkanlığı benzeyen kullanıcıların puanlarına göre hesaplanır. Diğer kullanıcıların kitaplarından yalnızca kütüphanedeki yazar veya etiketleri paylaşanlar değerlendirilir; private hesapların kitapları yalnızca onaylı takipçilerine önerilir. Kütüphanedeki kitaplar önerilmez. Hesaplama tamamen yereldir ve aynı veriyle her zaman aynı sonucu verir.
+// @Tags         recommendations
+// @Accept       json
+// @Produce      json
+// @Param        source  query     string  false  "wishlist veya public (boşsa ikisi birden)"
+// @Param        limit   query     int     false  "Öneri sayısı (varsayılan 20, en fazla 100)"
+// @Success      200     {array}   models.RecommendationResponse
+// @Failure      400     {object}  map[string]interface{}
+// @Failure      401     {object}  map[string]interface{}
+// @Failure      500     {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/recommendations [get]
+func GetRecommendations(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	limit := defaultRecommendationLimit
+	if value := c.Query("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > maxRecommendationLimit {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"status":  "error",
+				"message": "Geçersiz istek",
+				"error":   "limit 1 ile 100 arasında olmalıdır",
+			})
+			return
+		}
+		limit = parsed
+	}
+	source := c.Query("source")
+	if source != "" && source != models.RecommendationWishlist && source != models.RecommendationPublic {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz filtre",
+			"error":   fmt.Sprintf("geçersiz source: %q", source),
+		})
+		return
+	}
+
+	var ownBooks []models.Book
+	var publicBooks []recommendationBook
+	var wishlist []models.WishlistItem
+	err := config.DB.Select("title", "author", "tags", "rating", "isbn13").Where("user_id = ?", userID).Find(&ownBooks).Error
+	if err == nil && source != models.RecommendationPublic {
+		err = config.DB.Select("id", "title", "author", "tags", "isbn13", "priority").
+			Where("user_id = ?", userID).Order("id").Find(&wishlist).Error
+	}
+	if err == nil {
+		publicBooks, err = findRecommendationBooks(userID, ownBooks)
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Öneriler hesaplanamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Kütüphanedeki kitaplar başlık/yazar veya ISBN ile eşleşirse önerilmez
+	owned := map[string]bool{}
+	own := make([]utils.RatedBook, 0, len(ownBooks))
+	for _, book := range ownBooks {
+		key := bookDuplicateKey(book.Title, book.Author)
+		owned[key] = true
+		if book.ISBN13 != "" {
+			owned[isbnDuplicateKey(book.ISBN13)] = true
+		}
+		if book.Rating > 0 {
+			own = append(own, utils.RatedBook{Key: key, Author: book.Author, Tags: book.Tags, Rating: book.Rating})
+		}
+	}
+	isOwned := func(title, author, isbn13 string) bool {
+		return owned[bookDuplicateKey(title, author)] || (isbn13 != "" && owned[isbnDuplicateKey(isbn13)])
+	}
+
+	candidates := []utils.RecommendationCandidate{}
+	responses := map[string]models.RecommendationResponse{}
+	for _, item := range wishlist {
+		key := bookDuplicateKey(item.Title, item.Author)
+		if _, ok := responses[key]; ok || isOwned(item.Title, item.Author, item.ISBN13) {
+			continue
+		}
+		itemID := item.ID
+		candidates = append(candidates, utils.RecommendationCandidate{
+			Key: key, Author: item.Author, Tags: item.Tags, Boost: wishlistPriorityBoost[item.Priority],
+		})
+		responses[key] = models.RecommendationResponse{
+			Source:         models.RecommendationWishlist,
+			Title:          item.Title,
+			Author:         item.Author,
+			Tags:           item.Tags,
+			WishlistItemID: &itemID,
+		}
+	}
+
+	others := make([]utils.RatedBook, 0, len(publicBooks))
+	ratingSums, ratingCounts := map[string]float64{}, map[string]float64{}
+	for _, book := range publicBooks {
+		key := bookDuplicateKey(book.Title, book.Author)
+		others = append(others, utils.RatedBook{UserID: book.UserID, Key: key, Author: book.Author, Tags: book.Tags, Rating: book.Rating})
+		ratingSums[key] += float64(book.Rating)
+		ratingCounts[key]++
+
+		// Aynı kitap birden fazla kullanıcıda varsa ilk eklenen kayıt gösterilir
+		if source == models.RecommendationWishlist || book.Rating < recommendationMinRating {
+			continue
+		}
+		if _, ok := responses[key]; ok || isOwned(book.Title, book.Author, book.ISBN13) {
+			continue
+		}
+		bookID := book.ID
+		candidates = append(candidates, utils.RecommendationCandidate{Key: key, Author: book.Author, Tags: book.Tags})
+		responses[key] = models.RecommendationResponse{
+			Source:   models.RecommendationPublic,
+			Title:    book.Title,
+			Author:   book.Author,
+			Tags:     book.Tags,
+			BookID:   &bookID,
+			Username: book.Username,
+			URL:      fmt.Sprintf("/api/users/%s/books/%d", book.Username, book.ID),
+		}
+	}
+
+	recommendations := utils.RecommendBooks(own, others, candidates, limit)
+	result := make([]models.RecommendationResponse, 0, len(recommendations))
+	for _, recommendation := range recommendations {
+		response := responses[recommendation.Key]
+		response.Score = recommendation.Score
+		response.Reasons = []string{}
+		if response.Source == models.RecommendationWishlist {
+			response.Reasons = append(response.Reasons, "İstek listenizde")
+		}
+		response.Reasons = append(response.Reasons, recommendation.Reasons...)
+		if count := ratingCounts[recommendation.Key]; count > 0 {
+			response.AverageRating = math.Round(ratingSums[recommendation.Key]/count*100) / 100
+		}
+		if response.Tags == nil {
+			response.Tags = []string{}
+		}
+		result = append(result, response)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Öneriler başarıyla getirildi",
+		"data":    result,
+	})
+}
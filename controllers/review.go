@@ -0,0 +1,608 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-api/config"
+	"go-api/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// Bir kullanıcının saatte yapabileceği varsayılan beğeni ve yorum sayısı
+const (
+	defaultLikeRateLimit    = 60
+	defaultCommentRateLimit = 20
+	reviewRateWindow        = time.Hour
+)
+
+// reviewRateLimit ortam değişkeninden saatlik sınırı okur
+func reviewRateLimit(name string, fallback int) int {
+	limit, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || limit <= 0 {
+		return fallback
+	}
+	return limit
+}
+
+// rateLimitError saatlik işlem sınırı aşıldığında dönen hata. RetryAfter,
+// penceredeki en eski kaydın süresinin dolmasına kalan saniyedir.
+type rateLimitError struct {
+	Limit      int
+	RetryAfter int
+}
+
+func (e *rateLimitError) Error() string {
+	return fmt.Sprintf("saatte en fazla %d işlem yapılabilir", e.Limit)
+}
+
+// checkRateLimit sorgunun son bir saatteki kayıt sayısı sınıra ulaştıysa
+// *rateLimitError döner. Eşzamanlı istekler sınırı aşmasın diye işlem kaydını
+// ekleyen transaction içinde çağrılır.
+func checkRateLimit(query *gorm.DB, limit int) error {
+	var times []time.Time
+	err := query.Where("created_at > ?", time.Now().Add(-reviewRateWindow)).
+		Order("created_at DESC").
+		Offset(limit-1).
+		Limit(1).
+		Pluck("created_at", &times).Error
+	if err != nil || len(times) == 0 {
+		return err
+	}
+	retryAfter := int(time.Until(times[0].Add(reviewRateWindow)).Seconds()) + 1
+	return &rateLimitError{Limit: limit, RetryAfter: retryAfter}
+}
+
+// respondRateLimit hata bir rateLimitError ise 429 yanıtını yazar ve true döner
+func respondRateLimit(c *gin.Context, err error) bool {
+	var limitErr *rateLimitError
+	if !errors.As(err, &limitErr) {
+		return false
+	}
+	c.Header("Retry-After", strconv.Itoa(limitErr.RetryAfter))
+	c.JSON(http.StatusTooManyRequests, gin.H{
+		"status":  "error",
+		"message": "Çok fazla istek",
+		"error":   limitErr.Error(),
+	})
+	return true
+}
+
+// reviewActionQuery kullanıcının son işlemlerini sayan istek sınırı sorgusu
+func reviewActionQuery(tx *gorm.DB, userID interface{}, action string) *gorm.DB {
+	return tx.Model(&models.ReviewAction{}).Where("user_id = ? AND action = ?", userID, action)
+}
+
+// findPublicBook yol parametrelerindeki kullanıcının public kitabını bulur.
+// Private hesabın kitabı yalnızca onaylı takipçilerine açıktır. Hata durumunda
+// yanıtı yazar ve false döner.
+func findPublicBook(c *gin.Context) (models.Book, bool) {
+	var book models.Book
+
+	bookID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz kitap ID",
+			"error":   err.Error(),
+		})
+		return book, false
+	}
+
+	err = config.DB.Joins("User").
+		Where("books.id = ? AND books.visibility = ? AND User.username = ?", bookID, models.VisibilityPublic, c.Param("username")).
+		First(&book).Error
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Kitap bulunamadı",
+			"error":   err.Error(),
+		})
+		return book, false
+	}
+	if book.User != nil && !canViewProfile(c, *book.User) {
+		respondPrivateProfile(c)
+		return book, false
+	}
+	return book, true
+}
+
+// adjustBookCounter kitabın beğeni veya yorum sayacını değiştirir; sürüm artmaz.
+// Sayaç alanları modelde salt okunur olduğundan doğrudan SQL ile yazılır.
+func adjustBookCounter(tx *gorm.DB, bookID uint, column string, delta int) error {
+	return tx.Exec("UPDATE books SET "+column+" = "+column+" + ? WHERE id = ?", delta, bookID).Error
+}
+
+// newBookCommentResponse yorumu yanıta çevirir; comment.User yüklenmiş olmalıdır
+func newBookCommentResponse(comment models.BookComment) models.BookCommentResponse {
+	response := models.BookCommentResponse{BookComment: comment}
+	if comment.User != nil {
+		response.User = newPublicUser(*comment.User)
+	}
+	return response
+}
+
+// LikeBook godoc
+// @Summary      İncelemeyi beğenme
+// @Description  Public bir kitap incelemesini beğenir ve kitabın sahibine bildirim gönderir. Zaten beğenilmişse durum değişmez; geri alınıp yeniden beğenilen kitaplar için bildirim tekrarlanmaz. Geri alınan beğeniler de saatlik sınıra sayılır.
+// @Tags         reviews
+// @Accept       json
+// @Produce      json
+// @Param        username  path      string  true  "Kullanıcı adı"
+// @Param        id        path      int     true  "Kitap ID"
+// @Success      200       {object}  models.BookLikeResponse
+// @Success      201       {object}  models.BookLikeResponse
+// @Failure      400       {object}  map[string]interface{}
+// @Failure      401       {object}  map[string]interface{}
+// @Failure      404       {object}  map[string]interface{}
+// @Failure      429       {object}  map[string]interface{}
+// @Failure      500       {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/users/{username}/books/{id}/like [post]
+func LikeBook(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	book, ok := findPublicBook(c)
+	if !ok {
+		return
+	}
+
+	like := models.BookLike{BookID: book.ID, UserID: userID.(uint)}
+	var count int64
+	if err := config.DB.Model(&like).Where(&like).Count(&count).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kitap beğenilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if count > 0 {
+		c.JSON(http.StatusOK, gin.H{
+			"status":  "success",
+			"message": "Kitap zaten beğenildi",
+			"data":    models.BookLikeResponse{Liked: true, LikeCount: book.LikeCount},
+		})
+		return
+	}
+
+	limit := reviewRateLimit("BOOK_LIKE_RATE_LIMIT", defaultLikeRateLimit)
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := checkRateLimit(reviewActionQuery(tx, userID, models.ReviewActionLike), limit); err != nil {
+			return err
+		}
+		// Aynı kitabı daha önce beğenip geri alan kullanıcı için sahibine
+		// yeniden bildirim gönderilmez
+		var liked int64
+		err := tx.Model(&models.ReviewAction{}).
+			Where("user_id = ? AND action = ? AND book_id = ?", like.UserID, models.ReviewActionLike, book.ID).
+			Count(&liked).Error
+		if err != nil {
+			return err
+		}
+		action := models.ReviewAction{UserID: like.UserID, Action: models.ReviewActionLike, BookID: book.ID}
+		if err := tx.Create(&action).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&like).Error; err != nil {
+			return err
+		}
+		if err := adjustBookCounter(tx, book.ID, "like_count", 1); err != nil {
+			return err
+		}
+		if liked > 0 {
+			return nil
+		}
+		return notifyBookOwner(tx, book, userID.(uint), models.NotificationBookLiked, nil)
+	})
+	if respondRateLimit(c, err) {
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kitap beğenilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"status":  "success",
+		"message": "Kitap beğenildi",
+		"data":    models.BookLikeResponse{Liked: true, LikeCount: book.LikeCount + 1},
+	})
+}
+
+// UnlikeBook godoc
+// @Summary      Beğeniyi geri alma
+// @Description  Public bir kitap incelemesine verilen beğeniyi geri alır
+// @Tags         reviews
+// @Accept       json
+// @Produce      json
+// @Param        username  path      string  true  "Kullanıcı adı"
+// @Param        id        path      int     true  "Kitap ID"
+// @Success      200       {object}  models.BookLikeResponse
+// @Failure      400       {object}  map[string]interface{}
+// @Failure      401       {object}  map[string]interface{}
+// @Failure      404       {object}  map[string]interface{}
+// @Failure      500       {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/users/{username}/books/{id}/like [delete]
+func UnlikeBook(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	book, ok := findPublicBook(c)
+	if !ok {
+		return
+	}
+
+	var deleted int64
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("book_id = ? AND user_id = ?", book.ID, userID).Delete(&models.BookLike{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		deleted = result.RowsAffected
+		return adjustBookCounter(tx, book.ID, "like_count", -1)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Beğeni geri alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if deleted == 0 {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Beğeni bulunamadı",
+			"error":   "record not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Beğeni geri alındı",
+		"data":    models.BookLikeResponse{Liked: false, LikeCount: book.LikeCount - 1},
+	})
+}
+
+// GetBookComments godoc
+// @Summary      İnceleme yorumları
+// @Description  Public bir kitap incelemesinin yorumlarını eskiden yeniye listeler. Kimlik doğrulama gerektirmez.
+// @Tags         reviews
+// @Accept       json
+// @Produce      json
+// @Param        username  path      string  true  "Kullanıcı adı"
+// @Param        id        path      int     true  "Kitap ID"
+// @Success      200       {array}   models.BookCommentResponse
+// @Failure      400       {object}  map[string]interface{}
+// @Failure      404       {object}  map[string]interface{}
+// @Failure      500       {object}  map[string]interface{}
+// @Router       /api/users/{username}/books/{id}/comments [get]
+func GetBookComments(c *gin.Context) {
+	book, ok := findPublicBook(c)
+	if !ok {
+		return
+	}
+
+	var comments []models.BookComment
+	err := config.DB.Preload("User").Where("book_id = ?", book.ID).Order("created_at, id").Find(&comments).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Yorumlar alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	response := make([]models.BookCommentResponse, 0, len(comments))
+	for _, comment := range comments {
+		// Silinmiş kullanıcıların yorumları gösterilmez
+		if comment.User != nil {
+			response = append(response, newBookCommentResponse(comment))
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Yorumlar başarıyla getirildi",
+		"data":    response,
+	})
+}
+
+// CreateBookComment godoc
+// @Summary      İncelemeye yorum yazma
+// @Description  Public bir kitap incelemesine yorum ekler ve kitabın sahibine bildirim gönderir. Silinen yorumlar da saatlik sınıra sayılır.
+// @Tags         reviews
+// @Accept       json
+// @Produce      json
+// @Param        username  path      string                     true  "Kullanıcı adı"
+// @Param        id        path      int                        true  "Kitap ID"
+// @Param        comment   body      models.BookCommentRequest  true  "Yorum"
+// @Success      201       {object}  models.BookCommentResponse
+// @Failure      400       {object}  map[string]interface{}
+// @Failure      401       {object}  map[string]interface{}
+// @Failure      404       {object}  map[string]interface{}
+// @Failure      429       {object}  map[string]interface{}
+// @Failure      500       {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/users/{username}/books/{id}/comments [post]
+func CreateBookComment(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	book, ok := findPublicBook(c)
+	if !ok {
+		return
+	}
+
+	var request models.BookCommentRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+	body := strings.TrimSpace(request.Body)
+	if body == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   "yorum boş olamaz",
+		})
+		return
+	}
+
+	limit := reviewRateLimit("BOOK_COMMENT_RATE_LIMIT", defaultCommentRateLimit)
+	comment := models.BookComment{BookID: book.ID, UserID: userID.(uint), Body: body}
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := checkRateLimit(reviewActionQuery(tx, userID, models.ReviewActionComment), limit); err != nil {
+			return err
+		}
+		action := models.ReviewAction{UserID: comment.UserID, Action: models.ReviewActionComment, BookID: book.ID}
+		if err := tx.Create(&action).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&comment).Error; err != nil {
+			return err
+		}
+		if err := adjustBookCounter(tx, book.ID, "comment_count", 1); err != nil {
+			return err
+		}
+		return notifyBookOwner(tx, book, comment.UserID, models.NotificationBookCommented, &comment.ID)
+	})
+	if respondRateLimit(c, err) {
+		return
+	}
+	if err == nil {
+		err = config.DB.First(&comment.User, comment.UserID).Error
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Yorum eklenemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"status":  "success",
+		"message": "Yorum başarıyla eklendi",
+		"data":    newBookCommentResponse(comment),
+	})
+}
+
+// findBookComment public kitabın yol parametresindeki yorumunu bulur.
+// Hata durumunda yanıtı yazar ve false döner.
+func findBookComment(c *gin.Context, book models.Book) (models.BookComment, bool) {
+	var comment models.BookComment
+
+	commentID, err := strconv.ParseUint(c.Param("commentId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz yorum ID",
+			"error":   err.Error(),
+		})
+		return comment, false
+	}
+
+	if err := config.DB.Preload("User").Where("id = ? AND book_id = ?", commentID, book.ID).First(&comment).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Yorum bulunamadı",
+			"error":   err.Error(),
+		})
+		return comment, false
+	}
+	return comment, true
+}
+
+// UpdateBookComment godoc
+// @Summary      Yorum düzenleme
+// @Description  Yorumu düzenler; yalnızca yorumun yazarı düzenleyebilir
+// @Tags         reviews
+// @Accept       json
+// @Produce      json
+// @Param        username   path      string                     true  "Kullanıcı adı"
+// @Param        id         path      int                        true  "Kitap ID"
+// @Param        commentId  path      int                        true  "Yorum ID"
+// @Param        comment    body      models.BookCommentRequest  true  "Yorum"
+// @Success      200        {object}  models.BookCommentResponse
+// @Failure      400        {object}  map[string]interface{}
+// @Failure      401        {object}  map[string]interface{}
+// @Failure      403        {object}  map[string]interface{}
+// @Failure      404        {object}  map[string]interface{}
+// @Failure      500        {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/users/{username}/books/{id}/comments/{commentId} [put]
+func UpdateBookComment(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	book, ok := findPublicBook(c)
+	if !ok {
+		return
+	}
+	comment, ok := findBookComment(c, book)
+	if !ok {
+		return
+	}
+	if comment.UserID != userID.(uint) {
+		c.JSON(http.StatusForbidden, gin.H{
+			"status":  "error",
+			"message": "Bu işlem için yetkiniz yok",
+			"error":   "yorumu yalnızca yazarı düzenleyebilir",
+		})
+		return
+	}
+
+	var request models.BookCommentRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+	body := strings.TrimSpace(request.Body)
+	if body == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   "yorum boş olamaz",
+		})
+		return
+	}
+
+	comment.Body = body
+	if err := config.DB.Omit("User").Save(&comment).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Yorum güncellenemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Yorum başarıyla güncellendi",
+		"data":    newBookCommentResponse(comment),
+	})
+}
+
+// DeleteBookComment godoc
+// @Summary      Yorum silme
+// @Description  Yorumu siler; yorumun yazarı veya kitabın sahibi silebilir
+// @Tags         reviews
+// @Accept       json
+// @Produce      json
+// @Param        username   path      string  true  "Kullanıcı adı"
+// @Param        id         path      int     true  "Kitap ID"
+// @Param        commentId  path      int     true  "Yorum ID"
+// @Success      200        {object}  map[string]interface{}
+// @Failure      400        {object}  map[string]interface{}
+// @Failure      401        {object}  map[string]interface{}
+// @Failure      403        {object}  map[string]interface{}
+// @Failure      404        {object}  map[string]interface{}
+// @Failure      500        {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/users/{username}/books/{id}/comments/{commentId} [delete]
+func DeleteBookComment(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	book, ok := findPublicBook(c)
+	if !ok {
+		return
+	}
+	comment, ok := findBookComment(c, book)
+	if !ok {
+		return
+	}
+	if comment.UserID != userID.(uint) && book.UserID != userID.(uint) {
+		c.JSON(http.StatusForbidden, gin.H{
+			"status":  "error",
+			"message": "Bu işlem için yetkiniz yok",
+			"error":   "yorumu yalnızca yazarı veya kitabın sahibi silebilir",
+		})
+		return
+	}
+
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&comment).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("comment_id = ?", comment.ID).Delete(&models.Notification{}).Error; err != nil {
+			return err
+		}
+		return adjustBookCounter(tx, book.ID, "comment_count", -1)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Yorum silinemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Yorum başarıyla silindi",
+	})
+}
@@ -0,0 +1,560 @@
+package controllers
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go-api/config"
+	"go-api/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// errInvalidSeries kitap kullanıcının olmayan bir seriye bağlanmak istendiğinde döner
+var errInvalidSeries = errors.New("seri bulunamadı")
+
+// checkBookSeries kitabın serisinin kullanıcıya ait olduğunu doğrular ve
+// book.Series'e yükler. Serisi olmayan kitabın sırası da temizlenir.
+func checkBookSeries(tx *gorm.DB, book *models.Book) error {
+	book.Series = nil
+	if book.SeriesID != nil && *book.SeriesID == 0 {
+		book.SeriesID = nil
+	}
+	if book.SeriesID == nil {
+		book.SeriesPosition = nil
+		return nil
+	}
+
+	var series models.Series
+	if err := tx.Where("id = ? AND user_id = ?", *book.SeriesID, book.UserID).First(&series).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errInvalidSeries
+		}
+		return err
+	}
+	book.Series = &series
+	return nil
+}
+
+// loadBookSeries kitabın serisini book.Series'e yükler
+func loadBookSeries(tx *gorm.DB, book *models.Book) error {
+	book.Series = nil
+	if book.SeriesID == nil {
+		return nil
+	}
+	var series models.Series
+	if err := tx.First(&series, *book.SeriesID).Error; err != nil {
+		return err
+	}
+	book.Series = &series
+	return nil
+}
+
+// newSeriesResponse serinin okuma durumunu kitaplarından hesaplar. Tam sayı
+// sıralar okunmuş kitap olarak sayılır; ara sıralar (ör. 1.5) yalnızca listelenir.
+func newSeriesResponse(series models.Series, books []models.SeriesBook, withBooks bool) models.SeriesResponse {
+	response := models.SeriesResponse{
+		ID:         series.ID,
+		Name:       series.Name,
+		Author:     series.Author,
+		TotalBooks: series.TotalBooks,
+		ReadCount:  len(books),
+		CreatedAt:  series.CreatedAt,
+		UpdatedAt:  series.UpdatedAt,
+	}
+	if withBooks {
+		response.Books = books
+	}
+
+	read := map[int]bool{}
+	highest := 0
+	for _, book := range books {
+		if book.Position == nil || *book.Position != math.Trunc(*book.Position) {
+			continue
+		}
+		position := int(*book.Position)
+		read[position] = true
+		if position > highest {
+			highest = position
+		}
+	}
+
+	// Toplam bilinmiyorsa en yüksek sıranın bir sonrası da aday sayılır
+	last := series.TotalBooks
+	if last == 0 {
+		last = highest + 1
+	} else {
+		unread := series.TotalBooks
+		for position := range read {
+			if position <= series.TotalBooks {
+				unread--
+			}
+		}
+		response.UnreadCount = &unread
+	}
+	// İlk okunmamış sıra en geç len(read)+1'dir; döngü toplamdan bağımsız olarak kısa sürer
+	for position := 1; position <= last; position++ {
+		if !read[position] {
+			next := position
+			response.NextPosition = &next
+			break
+		}
+	}
+	return response
+}
+
+// userSeriesBooks kullanıcının serilerindeki kitapları seri ID'sine göre gruplar
+func userSeriesBooks(userID interface{}, seriesIDs ...uint) (map[uint][]models.SeriesBook, error) {
+	var books []models.Book
+	query := config.DB.Where("user_id = ? AND series_id IS NOT NULL", userID)
+	if len(seriesIDs) > 0 {
+		query = query.Where("series_id IN ?", seriesIDs)
+	}
+	if err := query.Order("series_position IS NULL, series_position, id").Find(&books).Error; err != nil {
+		return nil, err
+	}
+
+	grouped := map[uint][]models.SeriesBook{}
+	for _, book := range books {
+		grouped[*book.SeriesID] = append(grouped[*book.SeriesID], models.SeriesBook{
+			ID:       book.ID,
+			Title:    book.Title,
+			Author:   book.Author,
+			Rating:   book.Rating,
+			Position: book.SeriesPosition,
+		})
+	}
+	return grouped, nil
+}
+
+// findUserSeries kullanıcının serisini ID ile bulur
+func findUserSeries(userID interface{}, seriesID uint64) (models.Series, error) {
+	var series models.Series
+	err := config.DB.Where("id = ? AND user_id = ?", seriesID, userID).First(&series).Error
+	return series, err
+}
+
+// seriesNameTaken kullanıcının aynı adlı (büyük/küçük harf duyarsız) başka bir
+// serisi olup olmadığını kontrol eder
+func seriesNameTaken(userID interface{}, name string, exceptID uint) bool {
+	var count int64
+	config.DB.Model(&models.Series{}).
+		Where("user_id = ? AND LOWER(name) = LOWER(?) AND id <> ?", userID, strings.TrimSpace(name), exceptID).
+		Count(&count)
+	return count > 0
+}
+
+// GetSeries godoc
+// @Summary      Seri listesi
+// @Description  Kullanıcının serilerini okunan/okunmayan kitap sayıları ve sıradaki kitapla listeler
+// @Tags         series
+// @Accept       json
+// @Produce      json
+// @Success      200  {array}   models.SeriesResponse
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/series [get]
+func GetSeries(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	var series []models.Series
+	if err := config.DB.Where("user_id = ?", userID).Order("name COLLATE NOCASE, id").Find(&series).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Seriler alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	books, err := userSeriesBooks(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Seriler alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	response := make([]models.SeriesResponse, 0, len(series))
+	for _, item := range series {
+		response = append(response, newSeriesResponse(item, books[item.ID], false))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Seriler başarıyla getirildi",
+		"data":    response,
+	})
+}
+
+// GetNextInSeries godoc
+// @Summary      Serilerde sıradaki kitaplar
+// @Description  Okunmaya başlanmış serilerde okunacak sıradaki kitabın sırasını önerir. Toplam kitap sayısı bilinen ve tamamlanmış seriler listelenmez.
+// @Tags         series
+// @Accept       json
+// @Produce      json
+// @Success      200  {array}   models.SeriesResponse
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/series/next [get]
+func GetNextInSeries(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	var series []models.Series
+	if err := config.DB.Where("user_id = ?", userID).Order("name COLLATE NOCASE, id").Find(&series).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Seriler alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	books, err := userSeriesBooks(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Seriler alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	suggestions := []models.SeriesResponse{}
+	for _, item := range series {
+		if len(books[item.ID]) == 0 {
+			continue
+		}
+		if response := newSeriesResponse(item, books[item.ID], false); response.NextPosition != nil {
+			suggestions = append(suggestions, response)
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Sıradaki kitaplar başarıyla getirildi",
+		"data":    suggestions,
+	})
+}
+
+// GetSeriesDetail godoc
+// @Summary      Seri detayı
+// @Description  Seriyi kütüphanedeki kitaplarıyla sıralı olarak getirir
+// @Tags         series
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Seri ID"
+// @Success      200  {object}  models.SeriesResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/series/{id} [get]
+func GetSeriesDetail(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	seriesID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz seri ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	series, err := findUserSeries(userID, seriesID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Seri bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	books, err := userSeriesBooks(userID, series.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Seri kitapları alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	response := newSeriesResponse(series, books[series.ID], true)
+	if response.Books == nil {
+		response.Books = []models.SeriesBook{}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Seri başarıyla getirildi",
+		"data":    response,
+	})
+}
+
+// CreateSeries godoc
+// @Summary      Seri oluşturma
+// @Description  Yeni bir seri oluşturur; kitaplar series_id ile seriye bağlanır
+// @Tags         series
+// @Accept       json
+// @Produce      json
+// @Param        series  body      models.Series  true  "Seri bilgileri"
+// @Success      201     {object}  models.SeriesResponse
+// @Failure      400     {object}  map[string]interface{}
+// @Failure      401     {object}  map[string]interface{}
+// @Failure      409     {object}  map[string]interface{}
+// @Failure      500     {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/series [post]
+func CreateSeries(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	var series models.Series
+	if err := c.ShouldBindJSON(&series); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+	series.ID = 0
+	series.UserID = userID.(uint)
+	series.Name = strings.TrimSpace(series.Name)
+
+	if seriesNameTaken(userID, series.Name, 0) {
+		c.JSON(http.StatusConflict, gin.H{
+			"status":  "error",
+			"message": "Bu adla bir seri zaten var",
+			"error":   "seri adı kullanılıyor",
+		})
+		return
+	}
+
+	if err := config.DB.Create(&series).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Seri kaydedilemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"status":  "success",
+		"message": "Seri başarıyla oluşturuldu",
+		"data":    newSeriesResponse(series, nil, false),
+	})
+}
+
+// UpdateSeries godoc
+// @Summary      Seri güncelleme
+// @Description  Serinin adını, yazarını ve toplam kitap sayısını günceller
+// @Tags         series
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int            true  "Seri ID"
+// @Param        series  body      models.Series  true  "Seri bilgileri"
+// @Success      200     {object}  models.SeriesResponse
+// @Failure      400     {object}  map[string]interface{}
+// @Failure      401     {object}  map[string]interface{}
+// @Failure      404     {object}  map[string]interface{}
+// @Failure      409     {object}  map[string]interface{}
+// @Failure      500     {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/series/{id} [put]
+func UpdateSeries(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	seriesID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz seri ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	series, err := findUserSeries(userID, seriesID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Seri bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	id, createdAt := series.ID, series.CreatedAt
+	if err := c.ShouldBindJSON(&series); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+	series.ID = id
+	series.UserID = userID.(uint)
+	series.CreatedAt = createdAt
+	series.Name = strings.TrimSpace(series.Name)
+
+	if seriesNameTaken(userID, series.Name, series.ID) {
+		c.JSON(http.StatusConflict, gin.H{
+			"status":  "error",
+			"message": "Bu adla bir seri zaten var",
+			"error":   "seri adı kullanılıyor",
+		})
+		return
+	}
+
+	if err := config.DB.Save(&series).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Seri güncellenemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	books, err := userSeriesBooks(userID, series.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Seri kitapları alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Seri başarıyla güncellendi",
+		"data":    newSeriesResponse(series, books[series.ID], false),
+	})
+}
+
+// DeleteSeries godoc
+// @Summary      Seri silme
+// @Description  Seriyi siler; serideki kitaplar silinmez, yalnızca seriden çıkarılır
+// @Tags         series
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Seri ID"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/series/{id} [delete]
+func DeleteSeries(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	seriesID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz seri ID",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	series, err := findUserSeries(userID, seriesID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Seri bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Çöp kutusundaki kitaplar da seriden çıkarılır; sürümleri değiştiği için artırılır
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Unscoped().Model(&models.Book{}).Where("series_id = ?", series.ID).UpdateColumns(map[string]interface{}{
+			"series_id":       nil,
+			"series_position": nil,
+			"version":         gorm.Expr("version + 1"),
+		}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Delete(&series).Error
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Seri silinemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Seri başarıyla silindi",
+	})
+}
@@ -0,0 +1,365 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"go-api/config"
+	"go-api/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const (
+	// defaultStatsTopAuthors en çok okunan yazarlar listesinin varsayılan uzunluğu
+	defaultStatsTopAuthors = 10
+	// maxStatsTopAuthors top_authors parametresinin alabileceği en büyük değer
+	maxStatsTopAuthors = 50
+	// maxStreakReadDays başlangıç tarihi bilinen bir okumanın seriye katılan en fazla gün sayısı
+	maxStreakReadDays = 366
+)
+
+// statsRead istatistiklerin hesaplandığı okuma kaydı
+type statsRead struct {
+	BookID      uint
+	Title       string
+	Author      string
+	PageCount   int
+	PublishYear int
+	Tags        []string `gorm:"serializer:json"`
+	StartedAt   *time.Time
+	ReadDate    time.Time
+	Rating      int
+}
+
+// userReadsScope kullanıcının çöp kutusunda olmayan kitaplarının okumalarını
+// bitiş tarihi [from, to] aralığında olacak şekilde seçen sorguyu döner
+func userReadsScope(userID interface{}, from, to *time.Time) *gorm.DB {
+	scope := config.DB.Table("book_reads").
+		Joins("JOIN books ON books.id = book_reads.book_id AND books.deleted_at IS NULL").
+		Where("books.user_id = ?", userID)
+	if from != nil {
+		scope = scope.Where("book_reads.read_date >= ?", *from)
+	}
+	if to != nil {
+		scope = scope.Where("book_reads.read_date < ?", to.AddDate(0, 0, 1))
+	}
+	return scope
+}
+
+// scanStatsReads sorgudaki okumaları bitiş tarihine göre sıralı döner
+func scanStatsReads(scope *gorm.DB) ([]statsRead, error) {
+	var reads []statsRead
+	err := scope.Session(&gorm.Session{}).
+		Select("books.id AS book_id, books.title, books.author, books.page_count, books.publish_year, " +
+			"books.tags, book_reads.started_at, book_reads.read_date, book_reads.rating").
+		Order("book_reads.read_date, book_reads.id").
+		Scan(&reads).Error
+	return reads, err
+}
+
+// statsRange from/to (YYYY-MM-DD) veya year parametrelerinden tarih aralığını
+// okur. to günü aralığa dahildir; verilmeyen sınırlar nil döner.
+func statsRange(c *gin.Context) (*time.Time, *time.Time, error) {
+	var from, to *time.Time
+	if value := c.Query("year"); value != "" {
+		if c.Query("from") != "" || c.Query("to") != "" {
+			return nil, nil, errors.New("year, from ve to ile birlikte kullanılamaz")
+		}
+		year, err := strconv.Atoi(value)
+		if err != nil || year < 1 || year > 9999 {
+			return nil, nil, fmt.Errorf("geçersiz year: %q", value)
+		}
+		start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+		return &start, &end, nil
+	}
+
+	for param, target := range map[string]**time.Time{"from": &from, "to": &to} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		date, err := time.Parse(filterDateLayout, value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("geçersiz %s: %q", param, value)
+		}
+		*target = &date
+	}
+	if from != nil && to != nil && to.Before(*from) {
+		return nil, nil, errors.New("to, from tarihinden önce olamaz")
+	}
+	return from, to, nil
+}
+
+// averageRating puan toplamından iki basamaklı ortalama üretir; puan yoksa nil döner
+func averageRating(sum, count int) *float64 {
+	if count == 0 {
+		return nil
+	}
+	average := math.Round(float64(sum)/float64(count)*100) / 100
+	return &average
+}
+
+// periodStats okumaları verilen anahtara göre gruplar. İlk ve son dönem
+// arasındaki boş dönemler de sıfır değerle listelenir.
+func periodStats(reads []statsRead, index func(time.Time) int, label func(int) string) []models.PeriodStats {
+	type bucket struct{ books, pages, ratingSum, rated int }
+	buckets := map[int]*bucket{}
+	first, last := math.MaxInt, math.MinInt
+	for _, read := range reads {
+		key := index(read.ReadDate)
+		if buckets[key] == nil {
+			buckets[key] = &bucket{}
+		}
+		b := buckets[key]
+		b.books++
+		b.pages += read.PageCount
+		if read.Rating > 0 {
+			b.ratingSum += read.Rating
+			b.rated++
+		}
+		first, last = min(first, key), max(last, key)
+	}
+
+	periods := []models.PeriodStats{}
+	for key := first; len(buckets) > 0 && key <= last; key++ {
+		period := models.PeriodStats{Period: label(key)}
+		if b := buckets[key]; b != nil {
+			period.Books = b.books
+			period.Pages = b.pages
+			period.AverageRating = averageRating(b.ratingSum, b.rated)
+		}
+		periods = append(periods, period)
+	}
+	return periods
+}
+
+// monthIndex tarihi yıl*12+ay biçiminde sıralanabilir bir sayıya çevirir
+func monthIndex(date time.Time) int {
+	return date.Year()*12 + int(date.Month()) - 1
+}
+
+// monthLabel monthIndex değerini "2024-03" biçiminde yazar
+func monthLabel(index int) string {
+	return fmt.Sprintf("%04d-%02d", index/12, index%12+1)
+}
+
+// dayIndex tarihi Unix gün sayısına çevirir
+func dayIndex(date time.Time) int {
+	return int(date.UTC().Unix() / 86400)
+}
+
+// dayLabel dayIndex değerini "2024-03-15" biçiminde yazar
+func dayLabel(index int) string {
+	return time.Unix(int64(index)*86400, 0).UTC().Format(filterDateLayout)
+}
+
+// longestStreak sıralı ve tekrarsız değerlerdeki en uzun ardışık diziyi ve
+// reference veya reference-1 ile biten güncel dizinin uzunluğunu döner
+func longestStreak(values []int, reference int, label func(int) string) (*models.ReadingStreak, int) {
+	if len(values) == 0 {
+		return nil, 0
+	}
+
+	var longest *models.ReadingStreak
+	start := 0
+	for i := range values {
+		if i > 0 && values[i] != values[i-1]+1 {
+			start = i
+		}
+		if length := i - start + 1; longest == nil || length > longest.Length {
+			longest = &models.ReadingStreak{Length: length, Start: label(values[start]), End: label(values[i])}
+		}
+	}
+
+	current := 0
+	if last := values[len(values)-1]; last == reference || last == reference-1 {
+		current = len(values) - start
+	}
+	return longest, current
+}
+
+// sortedKeys kümedeki değerleri artan sırada döner
+func sortedKeys(set map[int]bool) []int {
+	keys := make([]int, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+// readingStreaks okumalardan gün ve ay serilerini hesaplar. Gün serilerinde
+// okumanın aralık dışında kalan günleri sayılmaz.
+func readingStreaks(reads []statsRead, from, to *time.Time, now time.Time) models.ReadingStreaks {
+	days := map[int]bool{}
+	months := map[int]bool{}
+	for _, read := range reads {
+		months[monthIndex(read.ReadDate)] = true
+
+		end := dayIndex(read.ReadDate)
+		start := end
+		if read.StartedAt != nil && read.StartedAt.Before(read.ReadDate) {
+			start = max(dayIndex(*read.StartedAt), end-maxStreakReadDays+1)
+		}
+		if from != nil {
+			start = max(start, dayIndex(*from))
+		}
+		for day := start; day <= end; day++ {
+			days[day] = true
+		}
+	}
+
+	reference := now
+	if to != nil && to.Before(now) {
+		reference = *to
+	}
+
+	var streaks models.ReadingStreaks
+	streaks.LongestDays, streaks.CurrentDays = longestStreak(sortedKeys(days), dayIndex(reference), dayLabel)
+	streaks.LongestMonths, streaks.CurrentMonths = longestStreak(sortedKeys(months), monthIndex(reference), monthLabel)
+	return streaks
+}
+
+// GetReadingStats godoc
+// @Summary      Okuma istatistikleri
+// @Description  Aylara ve yıllara göre okunan kitap ve sayfa sayıları, zaman içindeki ortalama puan, puan dağılımı, en çok okunan yazarlar, en uzun/en kısa kitaplar ve okuma serilerini döner. Yeniden okumalar dahil her okuma bitiş tarihine göre sayılır; çöp kutusundaki kitaplar katılmaz.
+// @Tags         stats
+// @Accept       json
+// @Produce      json
+// @Param        from         query     string  false  "Bu tarihten itibaren biten okumalar (YYYY-MM-DD)"
+// @Param        to           query     string  false  "Bu tarihe kadar biten okumalar (YYYY-MM-DD)"
+// @Param        year         query     int     false  "Yalnızca bu yıl; from ve to ile birlikte kullanılamaz"
+// @Param        top_authors  query     int     false  "En çok okunan yazar sayısı (varsayılan 10, en fazla 50)"
+// @Success      200          {object}  models.ReadingStatsResponse
+// @Failure      400          {object}  map[string]interface{}
+// @Failure      401          {object}  map[string]interface{}
+// @Failure      500          {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/stats [get]
+func GetReadingStats(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	from, to, err := statsRange(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz tarih aralığı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	topAuthors := defaultStatsTopAuthors
+	if value := c.Query("top_authors"); value != "" {
+		topAuthors, err = strconv.Atoi(value)
+		if err != nil || topAuthors < 0 || topAuthors > maxStatsTopAuthors {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"status":  "error",
+				"message": "Geçersiz istek",
+				"error":   fmt.Sprintf("top_authors 0 ile %d arasında olmalıdır", maxStatsTopAuthors),
+			})
+			return
+		}
+	}
+
+	// Okumalar ve yazar sayıları aynı aralık koşuluyla sorgulanır
+	scope := userReadsScope(userID, from, to)
+	reads, err := scanStatsReads(scope)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "İstatistikler alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	authors := []models.AuthorCount{}
+	if topAuthors > 0 {
+		err = scope.Session(&gorm.Session{}).
+			Select("authors.id, authors.name, COUNT(*) AS books").
+			Joins("JOIN book_authors ON book_authors.book_id = books.id").
+			Joins("JOIN authors ON authors.id = book_authors.author_id").
+			Group("authors.id").
+			Order("books DESC, authors.name COLLATE NOCASE").
+			Limit(topAuthors).
+			Scan(&authors).Error
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"status":  "error",
+				"message": "İstatistikler alınamadı",
+				"error":   err.Error(),
+			})
+			return
+		}
+	}
+
+	response := models.ReadingStatsResponse{
+		TotalBooks:    len(reads),
+		BooksPerYear:  periodStats(reads, func(date time.Time) int { return date.Year() }, strconv.Itoa),
+		BooksPerMonth: periodStats(reads, monthIndex, monthLabel),
+		TopAuthors:    authors,
+		Streaks:       readingStreaks(reads, from, to, time.Now().UTC()),
+	}
+	if from != nil {
+		value := from.Format(filterDateLayout)
+		response.From = &value
+	}
+	if to != nil {
+		value := to.Format(filterDateLayout)
+		response.To = &value
+	}
+
+	distribution := make([]models.RatingCount, 5)
+	for i := range distribution {
+		distribution[i].Rating = i + 1
+	}
+	books := map[uint]bool{}
+	ratingSum, rated := 0, 0
+	for _, read := range reads {
+		books[read.BookID] = true
+		response.TotalPages += read.PageCount
+		if read.Rating >= 1 && read.Rating <= 5 {
+			distribution[read.Rating-1].Count++
+			ratingSum += read.Rating
+			rated++
+		}
+
+		// Yeniden okunan kitaplar en uzun/en kısa listesinde bir kez değerlendirilir
+		if read.PageCount <= 0 {
+			continue
+		}
+		book := &models.StatsBook{ID: read.BookID, Title: read.Title, Author: read.Author, PageCount: read.PageCount}
+		if response.LongestBook == nil || read.PageCount > response.LongestBook.PageCount {
+			response.LongestBook = book
+		}
+		if response.ShortestBook == nil || read.PageCount < response.ShortestBook.PageCount {
+			response.ShortestBook = book
+		}
+	}
+	response.UniqueBooks = len(books)
+	response.AverageRating = averageRating(ratingSum, rated)
+	response.RatingDistribution = distribution
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "İstatistikler başarıyla getirildi",
+		"data":    response,
+	})
+}
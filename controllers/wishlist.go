@@ -0,0 +1,563 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go-api/config"
+	"go-api/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// wishlistOrders istek listesi sıralamaları; priority önce yüksek öncelikli,
+// price önce ucuz (fiyatı bilinmeyenler sonda), created önce en yeni öğeleri döner
+var wishlistOrders = map[string]string{
+	"priority": "CASE priority WHEN 'high' THEN 0 WHEN 'medium' THEN 1 ELSE 2 END, created_at DESC, id DESC",
+	"price":    "estimated_price IS NULL, estimated_price, created_at DESC, id DESC",
+	"created":  "created_at DESC, id DESC",
+}
+
+// applyWishlistRequest isteği öğeye uygular; ISBN'ler kitaplardaki gibi
+// normalize edilip doğrulanır
+func applyWishlistRequest(item *models.WishlistItem, request models.WishlistItemRequest) error {
+	isbn := models.Book{ISBN10: request.ISBN10, ISBN13: request.ISBN13}
+	if err := normalizeBookISBN(&isbn); err != nil {
+		return err
+	}
+
+	item.Title = strings.TrimSpace(request.Title)
+	item.Author = strings.TrimSpace(request.Author)
+	item.Summary = request.Summary
+	item.Tags = request.Tags
+	item.ISBN10 = isbn.ISBN10
+	item.ISBN13 = isbn.ISBN13
+	item.PageCount = request.PageCount
+	item.PublishYear = request.PublishYear
+	item.Priority = request.Priority
+	if item.Priority == "" {
+		item.Priority = models.WishlistPriorityMedium
+	}
+	item.Notes = request.Notes
+	item.EstimatedPrice = request.EstimatedPrice
+	item.Currency = strings.ToUpper(request.Currency)
+	return nil
+}
+
+// findWishlistItem :id parametresindeki öğeyi kullanıcının istek listesinde
+// bulur; bulunamazsa hata yanıtını yazar ve false döner
+func findWishlistItem(c *gin.Context, userID interface{}) (models.WishlistItem, bool) {
+	var item models.WishlistItem
+
+	itemID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek listesi öğesi ID",
+			"error":   err.Error(),
+		})
+		return item, false
+	}
+
+	if err := config.DB.Where("id = ? AND user_id = ?", itemID, userID).First(&item).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "İstek listesi öğesi bulunamadı",
+			"error":   err.Error(),
+		})
+		return item, false
+	}
+	return item, true
+}
+
+// GetWishlist godoc
+// @Summary      İstek listesi
+// @Description  Kullanıcının edinmek istediği kitapları listeler
+// @Tags         wishlist
+// @Accept       json
+// @Produce      json
+// @Param        priority  query     string  false  "low, medium veya high"
+// @Param        sort      query     string  false  "priority (varsayılan), price veya created"
+// @Success      200       {array}   models.WishlistItem
+// @Failure      400       {object}  map[string]interface{}
+// @Failure      401       {object}  map[string]interface{}
+// @Failure      500       {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/wishlist [get]
+func GetWishlist(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	sort := c.DefaultQuery("sort", "priority")
+	order, ok := wishlistOrders[sort]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz sıralama",
+			"error":   fmt.Sprintf("geçersiz sort: %q", sort),
+		})
+		return
+	}
+
+	query := config.DB.Where("user_id = ?", userID)
+	switch priority := c.Query("priority"); priority {
+	case "":
+	case models.WishlistPriorityLow, models.WishlistPriorityMedium, models.WishlistPriorityHigh:
+		query = query.Where("priority = ?", priority)
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz filtre",
+			"error":   fmt.Sprintf("geçersiz priority: %q", priority),
+		})
+		return
+	}
+
+	items := []models.WishlistItem{}
+	if err := query.Order(order).Find(&items).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "İstek listesi alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "İstek listesi başarıyla getirildi",
+		"data":    items,
+	})
+}
+
+// CreateWishlistItem godoc
+// @Summary      İstek listesine ekleme
+// @Description  Edinilmek istenen bir kitabı öncelik, nereden alınacağı notu ve tahmini fiyatla istek listesine ekler
+// @Tags         wishlist
+// @Accept       json
+// @Produce      json
+// @Param        item  body      models.WishlistItemRequest  true  "Kitap bilgileri"
+// @Success      201   {object}  models.WishlistItem
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/wishlist [post]
+func CreateWishlistItem(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	var request models.WishlistItemRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	item := models.WishlistItem{UserID: userID.(uint)}
+	if err := applyWishlistRequest(&item, request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if err := config.DB.Create(&item).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kitap istek listesine eklenemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"status":  "success",
+		"message": "Kitap istek listesine eklendi",
+		"data":    item,
+	})
+}
+
+// GetWishlistItem godoc
+// @Summary      İstek listesi öğesi
+// @Description  İstek listesindeki bir kitabı getirir
+// @Tags         wishlist
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Öğe ID"
+// @Success      200  {object}  models.WishlistItem
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/wishlist/{id} [get]
+func GetWishlistItem(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	item, ok := findWishlistItem(c, userID)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "İstek listesi öğesi başarıyla getirildi",
+		"data":    item,
+	})
+}
+
+// UpdateWishlistItem godoc
+// @Summary      İstek listesi öğesini güncelleme
+// @Description  İstek listesindeki kitabın bilgilerini, önceliğini, notunu ve fiyatını günceller
+// @Tags         wishlist
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int                         true  "Öğe ID"
+// @Param        item  body      models.WishlistItemRequest  true  "Kitap bilgileri"
+// @Success      200   {object}  models.WishlistItem
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      404   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/wishlist/{id} [put]
+func UpdateWishlistItem(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	item, ok := findWishlistItem(c, userID)
+	if !ok {
+		return
+	}
+
+	var request models.WishlistItemRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if err := applyWishlistRequest(&item, request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if err := config.DB.Save(&item).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "İstek listesi öğesi güncellenemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "İstek listesi öğesi başarıyla güncellendi",
+		"data":    item,
+	})
+}
+
+// DeleteWishlistItem godoc
+// @Summary      İstek listesinden silme
+// @Description  Kitabı istek listesinden kaldırır
+// @Tags         wishlist
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Öğe ID"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/wishlist/{id} [delete]
+func DeleteWishlistItem(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	item, ok := findWishlistItem(c, userID)
+	if !ok {
+		return
+	}
+
+	if err := config.DB.Delete(&item).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "İstek listesi öğesi silinemedi",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Kitap istek listesinden kaldırıldı",
+	})
+}
+
+// MoveWishlistItem godoc
+// @Summary      Kütüphaneye taşıma
+// @Description  İstek listesindeki kitabı okuma bilgileriyle kütüphaneye ekler ve listeden kaldırır. Başlık, yazar, özet, etiketler, ISBN, sayfa sayısı ve yayın yılı korunur. Kopya denetimi kitap eklemedeki gibidir: kütüphanede aynı kitap varsa 409 döner ve öğe listede kalır.
+// @Tags         wishlist
+// @Accept       json
+// @Produce      json
+// @Param        id               path      int                         true   "Öğe ID"
+// @Param        read             body      models.WishlistMoveRequest  true   "Okuma bilgileri"
+// @Param        allow_duplicate  query     bool                        false  "true ise aynı başlık ve yazara sahip kitap yine de eklenir (aynı ISBN'e izin verilmez)"
+// @Success      201   {object}  models.BookResponse
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      404   {object}  map[string]interface{}
+// @Failure      409   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/wishlist/{id}/move [post]
+func MoveWishlistItem(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	item, ok := findWishlistItem(c, userID)
+	if !ok {
+		return
+	}
+
+	var request models.WishlistMoveRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	book := models.Book{
+		UserID:      item.UserID,
+		Title:       item.Title,
+		Author:      item.Author,
+		Summary:     request.Summary,
+		ReadDate:    request.ReadDate,
+		Rating:      request.Rating,
+		Notes:       request.Notes,
+		Tags:        item.Tags,
+		ISBN10:      item.ISBN10,
+		ISBN13:      item.ISBN13,
+		PageCount:   item.PageCount,
+		PublishYear: item.PublishYear,
+		Visibility:  request.Visibility,
+	}
+	if book.Summary == "" {
+		book.Summary = item.Summary
+	}
+	if book.Summary == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Geçersiz istek",
+			"error":   "öğenin özeti olmadığından summary zorunludur",
+		})
+		return
+	}
+
+	duplicates, ok := createBook(c, &book, "Kitap kütüphaneye taşınamadı", func(tx *gorm.DB) error {
+		return tx.Delete(&item).Error
+	})
+	if !ok {
+		return
+	}
+
+	var user models.User
+	if err := config.DB.First(&user, userID).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Kullanıcı bilgileri alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	result := gin.H{
+		"status":  "success",
+		"message": "Kitap kütüphaneye taşındı",
+		"data":    newBookResponse(book, user),
+	}
+	if len(duplicates) > 0 {
+		result["duplicates"] = duplicates
+	}
+	c.JSON(http.StatusCreated, result)
+}
+
+// ShareWishlist godoc
+// @Summary      İstek listesini paylaşma
+// @Description  İstek listesi için yeni bir paylaşım bağlantısı oluşturur; önceki bağlantı geçersiz olur. Bağlantıyı bilen herkes listeyi görebilir.
+// @Tags         wishlist
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  models.WishlistShareResponse
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/wishlist/share [post]
+func ShareWishlist(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	token, err := newShareToken()
+	if err == nil {
+		err = config.DB.Model(&models.User{}).Where("id = ?", userID).Update("wishlist_share_token", token).Error
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Paylaşım bağlantısı oluşturulamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Paylaşım bağlantısı oluşturuldu",
+		"data":    models.WishlistShareResponse{ShareURL: "/api/shared/wishlists/" + *token},
+	})
+}
+
+// UnshareWishlist godoc
+// @Summary      İstek listesi paylaşımını kapatma
+// @Description  İstek listesinin paylaşım bağlantısını geçersiz kılar
+// @Tags         wishlist
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/wishlist/share [delete]
+func UnshareWishlist(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Yetkilendirme hatası",
+			"error":   "Kullanıcı bulunamadı",
+		})
+		return
+	}
+
+	if err := config.DB.Model(&models.User{}).Where("id = ?", userID).Update("wishlist_share_token", nil).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Paylaşım kapatılamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "İstek listesi paylaşımı kapatıldı",
+	})
+}
+
+// GetSharedWishlist godoc
+// @Summary      Paylaşılan istek listesi
+// @Description  Paylaşım bağlantısıyla bir kullanıcının istek listesini öncelik sırasıyla getirir. Kimlik doğrulama gerektirmez.
+// @Tags         public
+// @Accept       json
+// @Produce      json
+// @Param        token  path      string  true  "Paylaşım anahtarı"
+// @Success      200    {object}  models.SharedWishlistResponse
+// @Failure      404    {object}  map[string]interface{}
+// @Failure      500    {object}  map[string]interface{}
+// @Router       /api/shared/wishlists/{token} [get]
+func GetSharedWishlist(c *gin.Context) {
+	var user models.User
+	if err := config.DB.Where("wishlist_share_token = ?", c.Param("token")).First(&user).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "İstek listesi bulunamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	items := []models.WishlistItem{}
+	if err := config.DB.Where("user_id = ?", user.ID).Order(wishlistOrders["priority"]).Find(&items).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "İstek listesi alınamadı",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "İstek listesi başarıyla getirildi",
+		"data":    models.SharedWishlistResponse{User: newPublicUser(user), Items: items},
+	})
+}
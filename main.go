@@ -31,7 +31,7 @@ import (
 // @license.url   http://www.apache.org/licenses/LICENSE-2.0.html
 
 // @host      localhost:8000
-// @BasePath  /api
+// @BasePath  /
 // @schemes   http
 
 // @securityDefinitions.apikey BearerAuth
@@ -49,7 +49,13 @@ func main() {
 	// Komut satırı argümanlarını kontrol et
 	if len(os.Args) > 1 && os.Args[1] == "migrate" {
 		config.ConnectDatabase()
-		config.DB.AutoMigrate(&models.User{}, &models.Book{})
+		config.DB.AutoMigrate(&models.User{}, &models.Book{}, &models.BookRead{}, &models.ImportJob{}, &models.Highlight{}, &models.Author{}, &models.Series{}, &models.ReadingGoal{}, &models.ReadingChallenge{}, &models.Quote{}, &models.Follow{}, &models.Activity{}, &models.Club{}, &models.ClubMembership{}, &models.ClubInvitation{}, &models.ClubBook{}, &models.ClubPost{}, &models.BookLike{}, &models.BookComment{}, &models.Notification{}, &models.NotificationPreference{}, &models.Loan{}, &models.WishlistItem{}, &models.BookRevision{}, &models.ReviewAction{})
+		if err := config.MigrateBookReads(); err != nil {
+			log.Fatalf("Okuma kayıtları taşınamadı: %v", err)
+		}
+		if err := config.MigrateBookAuthors(); err != nil {
+			log.Fatalf("Yazarlar taşınamadı: %v", err)
+		}
 		fmt.Println("Veritabanı tabloları oluşturuldu!")
 		return
 	}
@@ -59,13 +65,40 @@ func main() {
 	// CORS ayarları
 	corsConfig := cors.DefaultConfig()
 	corsConfig.AllowOrigins = []string{"*"}
-	corsConfig.AllowMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}
-	corsConfig.AllowHeaders = []string{"Origin", "Content-Type", "Accept", "Authorization"}
+	corsConfig.AllowMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
+	corsConfig.AllowHeaders = []string{"Origin", "Content-Type", "Accept", "Authorization", "If-Match", "If-None-Match"}
+	corsConfig.ExposeHeaders = []string{"ETag"}
 	router.Use(cors.New(corsConfig))
 
 	// Veritabanı bağlantısı
 	config.ConnectDatabase()
 
+	// Önceki çalışmadan yarım kalan içe aktarma işlerini kapat
+	if count, err := config.FailStaleImportJobs(); err != nil {
+		log.Println("Yarım kalan içe aktarma işleri güncellenemedi:", err)
+	} else if count > 0 {
+		log.Printf("Yarım kalan %d içe aktarma işi başarısız olarak işaretlendi", count)
+	}
+
+	// ISBN aramalarında kullanılacak kitap bilgisi sağlayıcısı
+	config.ConnectMetadataProvider()
+
+	// Kapak görselleri için depolama; yerel depolamadaki dosyalar statik sunulur
+	config.ConnectStorage()
+	if config.LocalStorageDir != "" {
+		router.Static(config.LocalStorageRoute, config.LocalStorageDir)
+	}
+
+	// Süresi dolan çöp kutusu kayıtlarını temizle
+	config.StartTrashPurge()
+
+	// Bildirimleri e-posta ve webhook ile ilet
+	config.ConnectMailer()
+	config.StartNotificationDispatch()
+
+	// İade tarihi yaklaşan ve geçen ödünç kitaplar için hatırlatma gönder
+	config.StartLoanReminders()
+
 	// Swagger endpoint'i
 	url := ginSwagger.URL("http://localhost:8000/swagger/doc.json") // Swagger JSON dosyasının URL'i
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, url))
@@ -73,6 +106,19 @@ func main() {
 	// Route'ları ayarla
 	routes.SetupAuthRoutes(router)
 	routes.SetupBookRoutes(router)
+	routes.SetupAuthorRoutes(router)
+	routes.SetupSeriesRoutes(router)
+	routes.SetupStatsRoutes(router)
+	routes.SetupGoalRoutes(router)
+	routes.SetupQuoteRoutes(router)
+	routes.SetupPublicRoutes(router)
+	routes.SetupFollowRoutes(router)
+	routes.SetupClubRoutes(router)
+	routes.SetupReviewRoutes(router)
+	routes.SetupNotificationRoutes(router)
+	routes.SetupLoanRoutes(router)
+	routes.SetupWishlistRoutes(router)
+	routes.SetupRecommendationRoutes(router)
 
 	// Port ayarı
 	port := ":8000"
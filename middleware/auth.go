@@ -10,6 +10,25 @@ import (
 	"go-api/utils"
 )
 
+// OptionalAuthMiddleware geçerli bir Bearer token varsa user_id'yi ayarlar;
+// token yoksa veya geçersizse isteği anonim olarak sürdürür
+func OptionalAuthMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		parts := strings.Split(c.GetHeader("Authorization"), " ")
+		if len(parts) == 2 && parts[0] == "Bearer" {
+			token, err := utils.ValidateToken(parts[1])
+			if err == nil && token.Valid {
+				if claims, ok := token.Claims.(jwt.MapClaims); ok {
+					if userID, ok := claims["user_id"].(float64); ok {
+						c.Set("user_id", uint(userID))
+					}
+				}
+			}
+		}
+		c.Next()
+	}
+}
+
 func AuthMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		bearerToken := c.GetHeader("Authorization")
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"go-api/config"
+	"go-api/models"
+)
+
+// ClubMemberMiddleware :id parametresindeki kulübe üyeliği doğrular ve üyeliği
+// "club_membership" olarak context'e koyar. AuthMiddleware'den sonra
+// kullanılmalıdır. Üye olunmayan kulüpler, varlıkları belli olmasın diye
+// bulunamadı olarak döner.
+func ClubMemberMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		clubID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"status":  "error",
+				"message": "Geçersiz kulüp ID",
+				"error":   err.Error(),
+			})
+			c.Abort()
+			return
+		}
+
+		var membership models.ClubMembership
+		err = config.DB.Preload("Club").
+			Where("club_id = ? AND user_id = ?", clubID, c.GetUint("user_id")).
+			First(&membership).Error
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{
+				"status":  "error",
+				"message": "Kulüp bulunamadı",
+				"error":   err.Error(),
+			})
+			c.Abort()
+			return
+		}
+
+		c.Set("club_membership", membership)
+		c.Next()
+	}
+}
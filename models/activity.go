@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+)
+
+// Etkinlik türleri
+const (
+	ActivityFinished = "finished" // kitap (yeniden) bitirildi
+	ActivityRated    = "rated"    // kitabın puanı değişti
+)
+
+// Activity kullanıcının public bir kitapla ilgili etkinliği. Kitap sonradan
+// gizlenir veya silinirse etkinlik akışta gösterilmez.
+type Activity struct {
+	ID        uint      `json:"id" gorm:"primarykey;autoIncrement"`
+	UserID    uint      `json:"-" gorm:"not null;index"`
+	BookID    uint      `json:"-" gorm:"not null;index"`
+	Type      string    `json:"type" gorm:"size:16;not null"`
+	Rating    int       `json:"rating"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	User      *User     `json:"-" gorm:"foreignKey:UserID"`
+	Book      *Book     `json:"-" gorm:"foreignKey:BookID"`
+}
+
+// ActivityBook akışta gösterilen kitap bilgisi
+type ActivityBook struct {
+	ID           uint   `json:"id"`
+	Title        string `json:"title"`
+	Author       string `json:"author"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+}
+
+// ActivityResponse akıştaki bir etkinlik
+type ActivityResponse struct {
+	ID        uint         `json:"id"`
+	Type      string       `json:"type"`
+	Rating    int          `json:"rating,omitempty"`
+	User      PublicUser   `json:"user"`
+	Book      ActivityBook `json:"book"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+// FeedResponse akış sayfası. NextCursor, sonraki sayfa için cursor
+// parametresine verilir; son sayfada boştur.
+type FeedResponse struct {
+	Items      []ActivityResponse `json:"items"`
+	NextCursor string             `json:"next_cursor,omitempty"`
+}
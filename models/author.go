@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+)
+
+// Author kullanıcının kitaplarındaki bir yazar. NameKey, yazım farklarını
+// ("J.R.R. Tolkien" / "J. R. R. Tolkien") aynı yazarda birleştiren anahtardır.
+type Author struct {
+	ID        uint      `json:"id" gorm:"primarykey;autoIncrement"`
+	UserID    uint      `json:"-" gorm:"not null;uniqueIndex:idx_authors_user_key"`
+	Name      string    `json:"name" gorm:"size:255;not null"`
+	NameKey   string    `json:"-" gorm:"size:255;not null;uniqueIndex:idx_authors_user_key"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+	Books     []Book    `json:"-" gorm:"many2many:book_authors"`
+}
+
+// AuthorSummary kitap detayında gösterilen yazar bilgisi
+type AuthorSummary struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+// AuthorResponse yazar listesi için kitap sayısı ve ortalama puanı içeren response
+type AuthorResponse struct {
+	ID            uint     `json:"id"`
+	Name          string   `json:"name"`
+	BookCount     int      `json:"book_count"`
+	AverageRating *float64 `json:"average_rating"`
+}
+
+// AuthorMergeRequest verilen yazarları hedef yazarda birleştirme isteği
+type AuthorMergeRequest struct {
+	AuthorIDs []uint `json:"author_ids" binding:"required,min=1"`
+}
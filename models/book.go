@@ -6,44 +6,162 @@ import (
 	"gorm.io/gorm"
 )
 
+// Book kullanıcının kütüphanesindeki bir kitap. Okuma geçmişi BookRead
+// tablosundadır.
 type Book struct {
-	ID        uint           `json:"id" gorm:"primarykey;autoIncrement"`
-	UserID    uint           `json:"user_id" gorm:"not null"`
-	Title     string         `json:"title" binding:"required" gorm:"size:255;not null"`
-	Author    string         `json:"author" binding:"required" gorm:"size:255;not null"`
-	Summary   string         `json:"summary" binding:"required" gorm:"type:text;not null"`
-	ReadDate  time.Time      `json:"read_date" binding:"required"`
-	Rating    int            `json:"rating" binding:"required,min=1,max=5" gorm:"not null"`
-	Notes     string         `json:"notes" gorm:"type:text"`
-	CreatedAt time.Time      `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
-	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
-	User      *User          `json:"-" gorm:"foreignKey:UserID;references:ID"`
+	ID     uint   `json:"id" gorm:"primarykey;autoIncrement"`
+	UserID uint   `json:"user_id" gorm:"not null;uniqueIndex:idx_books_user_isbn13,where:isbn13 <> '' AND deleted_at IS NULL"`
+	Title  string `json:"title" binding:"required" gorm:"size:255;not null"`
+	// Author serbest metin olarak gösterilir, ayrıştırılmış yazarlar Authors'tadır
+	Author  string `json:"author" binding:"required" gorm:"size:255;not null"`
+	Summary string `json:"summary" binding:"required" gorm:"type:text;not null"`
+	// ReadDate ve Rating en son okumanın değerleridir; Rating 0, içe aktarmada
+	// puansız oluşturulan kitabı belirtir
+	ReadDate time.Time `json:"read_date" binding:"required"`
+	Rating   int       `json:"rating" binding:"required,min=1,max=5" gorm:"not null"`
+	Notes    string    `json:"notes" gorm:"type:text"`
+	Tags     []string  `json:"tags" gorm:"serializer:json"`
+	// ISBN'ler tire ve boşluksuz saklanır; ISBN-13 kullanıcının çöp kutusunda
+	// olmayan kitapları arasında tekildir
+	ISBN10      string `json:"isbn10" gorm:"size:10"`
+	ISBN13      string `json:"isbn13" gorm:"size:13;index;uniqueIndex:idx_books_user_isbn13"`
+	PageCount   int    `json:"page_count" binding:"min=0"`
+	PublishYear int    `json:"publish_year" binding:"omitempty,min=1,max=9999"`
+	SeriesID    *uint  `json:"series_id" gorm:"index"`
+	// SeriesPosition ara kitaplar için ondalıklı olabilir (ör. 1.5)
+	SeriesPosition *float64 `json:"series_position" binding:"omitempty,gt=0"`
+	Visibility     string   `json:"visibility" binding:"omitempty,oneof=private unlisted public" gorm:"size:16;not null;default:private;index"`
+	// ShareNotes açıksa Notes de paylaşılır
+	ShareNotes bool `json:"share_notes" gorm:"not null;default:false"`
+	// ShareToken private olmayan kitapların paylaşım bağlantısıdır
+	ShareToken *string `json:"-" gorm:"size:32;uniqueIndex"`
+	// CoverKey ve ThumbnailKey kapak ve küçük resmin depolama anahtarlarıdır
+	CoverKey     string `json:"-" gorm:"size:255"`
+	ThumbnailKey string `json:"-" gorm:"size:255"`
+	// LikeCount ve CommentCount beğeni ve yorumlarla güncellenen sayaçlardır;
+	// kitap kaydedilirken yazılmaz
+	LikeCount    int            `json:"-" gorm:"<-:false;not null;default:0"`
+	CommentCount int            `json:"-" gorm:"<-:false;not null;default:0"`
+	Version      uint           `json:"version" gorm:"not null;default:1"`
+	CreatedAt    time.Time      `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
+	DeletedAt    gorm.DeletedAt `json:"-" gorm:"index"`
+	User         *User          `json:"-" gorm:"foreignKey:UserID;references:ID"`
+	Reads        []BookRead     `json:"-" gorm:"foreignKey:BookID"`
+	Authors      []Author       `json:"-" gorm:"many2many:book_authors"`
+	Series       *Series        `json:"-" gorm:"foreignKey:SeriesID;<-:false"`
+}
+
+// Kitap görünürlükleri: private yalnızca sahibine, unlisted paylaşım
+// bağlantısını bilenlere, public ayrıca profil sayfasında görünür
+const (
+	VisibilityPrivate  = "private"
+	VisibilityUnlisted = "unlisted"
+	VisibilityPublic   = "public"
+)
+
+// BeforeCreate yeni kitapların sürümünü 1'den başlatır
+func (b *Book) BeforeCreate(tx *gorm.DB) error {
+	if b.Version == 0 {
+		b.Version = 1
+	}
+	return nil
 }
 
 // BookListResponse kitap listesi için özet response
 type BookListResponse struct {
-	ID     uint   `json:"id"`
-	Title  string `json:"title"`
-	Author string `json:"author"`
-	Rating int    `json:"rating"`
+	ID           uint   `json:"id"`
+	Title        string `json:"title"`
+	Author       string `json:"author"`
+	Rating       int    `json:"rating"`
+	CoverURL     string `json:"cover_url,omitempty"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
 }
 
-// BookResponse detaylı kitap bilgileri için response
-type BookResponse struct {
+// BookExport JSON dışa aktarmada her kitap için yazılan kayıt
+type BookExport struct {
+	ID          uint       `json:"id"`
+	Title       string     `json:"title"`
+	Author      string     `json:"author"`
+	Summary     string     `json:"summary"`
+	ReadDate    time.Time  `json:"read_date"`
+	Rating      int        `json:"rating"`
+	Notes       string     `json:"notes"`
+	Tags        []string   `json:"tags"`
+	ISBN10      string     `json:"isbn10,omitempty"`
+	ISBN13      string     `json:"isbn13,omitempty"`
+	PageCount   int        `json:"page_count,omitempty"`
+	PublishYear int        `json:"publish_year,omitempty"`
+	Reads       []BookRead `json:"reads"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// TrashedBookResponse çöp kutusundaki kitaplar için response
+type TrashedBookResponse struct {
 	ID        uint      `json:"id"`
 	Title     string    `json:"title"`
 	Author    string    `json:"author"`
-	Summary   string    `json:"summary"`
-	ReadDate  time.Time `json:"read_date"`
 	Rating    int       `json:"rating"`
-	Notes     string    `json:"notes,omitempty"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
-	User      struct {
+	DeletedAt time.Time `json:"deleted_at"`
+	PurgeAt   time.Time `json:"purge_at"`
+}
+
+// BookResponse detaylı kitap bilgileri için response
+type BookResponse struct {
+	ID           uint           `json:"id"`
+	Title        string         `json:"title"`
+	Author       string         `json:"author"`
+	Summary      string         `json:"summary"`
+	ReadDate     time.Time      `json:"read_date"`
+	Rating       int            `json:"rating"`
+	Notes        string         `json:"notes,omitempty"`
+	Tags         []string       `json:"tags"`
+	ISBN10       string         `json:"isbn10,omitempty"`
+	ISBN13       string         `json:"isbn13,omitempty"`
+	PageCount    int            `json:"page_count,omitempty"`
+	PublishYear  int            `json:"publish_year,omitempty"`
+	CoverURL     string         `json:"cover_url,omitempty"`
+	ThumbnailURL string         `json:"thumbnail_url,omitempty"`
+	Visibility   string         `json:"visibility"`
+	ShareNotes   bool           `json:"share_notes"`
+	ShareURL     string         `json:"share_url,omitempty"`
+	LikeCount    int            `json:"like_count"`
+	CommentCount int            `json:"comment_count"`
+	Version      uint           `json:"version"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	Series       *SeriesSummary `json:"series,omitempty"`
+	// Authors, Author metninden ayrıştırılmış yazarlar
+	Authors []AuthorSummary `json:"authors"`
+	// LatestRead en son okuma, Reads ise yeniden eskiye okuma geçmişi
+	LatestRead *BookRead  `json:"latest_read,omitempty"`
+	Reads      []BookRead `json:"reads"`
+	User       struct {
 		ID        uint   `json:"id"`
 		FirstName string `json:"first_name"`
 		LastName  string `json:"last_name"`
 		Username  string `json:"username"`
 	} `json:"user"`
 }
+
+// BookPatch kısmi güncellemede değiştirilebilen alanlar. Yalnızca gönderilen
+// (nil olmayan) alanlar doğrulanır ve kaydedilir.
+type BookPatch struct {
+	Title       *string    `json:"title" binding:"omitempty,min=1,max=255"`
+	Author      *string    `json:"author" binding:"omitempty,min=1,max=255"`
+	Summary     *string    `json:"summary" binding:"omitempty,min=1"`
+	ReadDate    *time.Time `json:"read_date"`
+	Rating      *int       `json:"rating" binding:"omitempty,min=1,max=5"`
+	Notes       *string    `json:"notes"`
+	Tags        *[]string  `json:"tags" binding:"omitempty,dive,min=1,max=64"`
+	ISBN10      *string    `json:"isbn10"`
+	ISBN13      *string    `json:"isbn13"`
+	PageCount   *int       `json:"page_count" binding:"omitempty,min=0"`
+	PublishYear *int       `json:"publish_year" binding:"omitempty,min=0,max=9999"`
+	// SeriesID ve SeriesPosition için 0 seriden çıkarma anlamına gelir
+	SeriesID       *uint    `json:"series_id"`
+	SeriesPosition *float64 `json:"series_position" binding:"omitempty,min=0"`
+	Visibility     *string  `json:"visibility" binding:"omitempty,oneof=private unlisted public"`
+	ShareNotes     *bool    `json:"share_notes"`
+}
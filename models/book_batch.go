@@ -0,0 +1,33 @@
+package models
+
+import (
+	"encoding/json"
+)
+
+// BookBatchOperation toplu istekteki tek bir işlem. create için book tam kitap
+// bilgilerini, update için yalnızca değişecek alanları içerir. version
+// gönderilirse update ve delete yalnızca bu sürümde uygulanır.
+type BookBatchOperation struct {
+	Op      string          `json:"op" binding:"required,oneof=create update delete"`
+	ID      uint            `json:"id"`
+	Version uint            `json:"version"`
+	Book    json.RawMessage `json:"book" swaggertype:"object"`
+}
+
+// BookBatchRequest toplu kitap işlemleri isteği. atomic varsayılan olarak
+// true'dur; false ise her işlem bağımsız uygulanır.
+type BookBatchRequest struct {
+	Atomic     *bool                `json:"atomic"`
+	Operations []BookBatchOperation `json:"operations" binding:"required,min=1,dive"`
+}
+
+// BookBatchResult toplu istekteki bir işlemin sonucu. Duplicates create
+// işlemlerinde bulunan olası kopyalardır.
+type BookBatchResult struct {
+	Index      int                  `json:"index"`
+	Op         string               `json:"op"`
+	Status     string               `json:"status"`
+	ID         uint                 `json:"id,omitempty"`
+	Error      string               `json:"error,omitempty"`
+	Duplicates []DuplicateCandidate `json:"duplicates,omitempty"`
+}
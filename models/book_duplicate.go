@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+)
+
+// Kopya eşleşme nedenleri
+const (
+	DuplicateISBN        = "isbn"         // ISBN-13'ler aynı
+	DuplicateExact       = "exact"        // başlık ve yazar normalize edildiğinde aynı
+	DuplicateTitleAuthor = "title_author" // başlık ve yazar birbirine çok benziyor
+)
+
+// DuplicateBook kopya raporlarında gösterilen kitap özeti
+type DuplicateBook struct {
+	ID       uint      `json:"id"`
+	Title    string    `json:"title"`
+	Author   string    `json:"author"`
+	ISBN13   string    `json:"isbn13,omitempty"`
+	ReadDate time.Time `json:"read_date"`
+	Rating   int       `json:"rating"`
+}
+
+// DuplicateCandidate eklenen kitabın kopyası olabilecek mevcut kitap. Score
+// 0 ile 1 arasındaki benzerliktir.
+type DuplicateCandidate struct {
+	DuplicateBook
+	Score  float64 `json:"score"`
+	Reason string  `json:"reason"`
+}
+
+// DuplicateGroup birbirinin kopyası olabilecek kitaplar. Score gruptaki en
+// yüksek benzerliktir.
+type DuplicateGroup struct {
+	Score  float64         `json:"score"`
+	Reason string          `json:"reason"`
+	Books  []DuplicateBook `json:"books"`
+}
+
+// BookMergeRequest kopya kitapları hedef kitapla birleştirme isteği
+type BookMergeRequest struct {
+	SourceIDs []uint `json:"source_ids" binding:"required,min=1,dive,required"`
+}
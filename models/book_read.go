@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+)
+
+// BookRead bir kitabın tek bir okunuşunu temsil eder
+type BookRead struct {
+	ID        uint       `json:"id" gorm:"primarykey;autoIncrement"`
+	BookID    uint       `json:"book_id" gorm:"not null;index"`
+	StartedAt *time.Time `json:"started_at"`
+	ReadDate  time.Time  `json:"read_date" gorm:"not null"`
+	Rating    int        `json:"rating" gorm:"not null"`
+	Notes     string     `json:"notes" gorm:"type:text"`
+	CreatedAt time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// BookReadRequest okuma kaydı ekleme ve güncelleme isteği
+type BookReadRequest struct {
+	StartedAt *time.Time `json:"started_at"`
+	ReadDate  time.Time  `json:"read_date" binding:"required"`
+	Rating    int        `json:"rating" binding:"required,min=1,max=5"`
+	Notes     string     `json:"notes"`
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+)
+
+// Fark satırı türleri
+const (
+	DiffEqual  = "equal"
+	DiffInsert = "insert"
+	DiffDelete = "delete"
+)
+
+// Revizyon kaynakları
+const (
+	RevisionInitial = "initial" // ilk değişiklikten önceki hal
+	RevisionUpdate  = "update"  // PUT veya PATCH ile güncelleme
+	RevisionMerge   = "merge"   // kopya kitapların birleştirilmesi
+	RevisionRestore = "restore" // eski bir revizyonun geri yüklenmesi
+)
+
+// BookRevision kitabın özet ve notlarının bir andaki hali. Revizyonlar her
+// kitapta 1'den başlayarak numaralanır; özet veya notlar her değiştiğinde
+// yeni hal kaydedilir. Revizyonu olmayan bir kitap ilk kez değiştiğinde
+// önceki hali de initial olarak kaydedilir. RestoredFrom restore
+// revizyonlarında geri yüklenen revizyonun numarasıdır.
+type BookRevision struct {
+	ID           uint      `json:"-" gorm:"primarykey;autoIncrement"`
+	BookID       uint      `json:"book_id" gorm:"not null;uniqueIndex:idx_book_revision"`
+	Revision     int       `json:"revision" gorm:"not null;uniqueIndex:idx_book_revision"`
+	Source       string    `json:"source" gorm:"size:16;not null"`
+	RestoredFrom int       `json:"restored_from,omitempty"`
+	Summary      string    `json:"summary" gorm:"type:text"`
+	Notes        string    `json:"notes" gorm:"type:text"`
+	CreatedAt    time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// BookRevisionDiff iki revizyon arasındaki satır satır fark
+type BookRevisionDiff struct {
+	From    int        `json:"from"`
+	To      int        `json:"to"`
+	Summary []DiffLine `json:"summary"`
+	Notes   []DiffLine `json:"notes"`
+}
+
+// DiffLine satır farkındaki tek bir satır
+type DiffLine struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
@@ -0,0 +1,165 @@
+package models
+
+import (
+	"time"
+)
+
+// Kulüp rolleri; owner kulübü silebilir ve rolleri değiştirebilir, moderator
+// üye davet eder, okuma listesini yönetir ve gönderileri siler
+const (
+	ClubOwner     = "owner"
+	ClubModerator = "moderator"
+	ClubMember    = "member"
+)
+
+// Kulüp kitabı durumları; kulübün aynı anda tek bir current kitabı olur
+const (
+	ClubBookCurrent  = "current"
+	ClubBookUpcoming = "upcoming"
+	ClubBookFinished = "finished"
+)
+
+// Club kullanıcıların birlikte okuduğu kitap kulübü
+type Club struct {
+	ID          uint      `json:"id" gorm:"primarykey;autoIncrement"`
+	Name        string    `json:"name" gorm:"size:255;not null"`
+	Description string    `json:"description" gorm:"type:text"`
+	CreatedAt   time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// ClubRequest kulüp oluşturma ve güncelleme isteği
+type ClubRequest struct {
+	Name        string `json:"name" binding:"required,max=255"`
+	Description string `json:"description"`
+}
+
+// ClubMembership kullanıcının kulüpteki üyeliği ve rolü
+type ClubMembership struct {
+	ID        uint      `json:"id" gorm:"primarykey;autoIncrement"`
+	ClubID    uint      `json:"-" gorm:"not null;uniqueIndex:idx_club_memberships_club_user"`
+	UserID    uint      `json:"-" gorm:"not null;uniqueIndex:idx_club_memberships_club_user;index"`
+	Role      string    `json:"role" gorm:"size:16;not null"`
+	CreatedAt time.Time `json:"joined_at" gorm:"autoCreateTime"`
+	Club      *Club     `json:"-" gorm:"foreignKey:ClubID"`
+	User      *User     `json:"-" gorm:"foreignKey:UserID"`
+}
+
+// ClubMemberResponse kulüp üyesi
+type ClubMemberResponse struct {
+	UserID   uint       `json:"user_id"`
+	Role     string     `json:"role"`
+	User     PublicUser `json:"user"`
+	JoinedAt time.Time  `json:"joined_at"`
+}
+
+// ClubRoleRequest üye rolü değiştirme isteği; owner verilirse sahiplik
+// devredilir ve eski sahip moderator olur
+type ClubRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=owner moderator member"`
+}
+
+// ClubInvitation kulübe davet; davet kabul edildiğinde veya reddedildiğinde silinir
+type ClubInvitation struct {
+	ID          uint      `json:"id" gorm:"primarykey;autoIncrement"`
+	ClubID      uint      `json:"-" gorm:"not null;uniqueIndex:idx_club_invitations_club_user"`
+	UserID      uint      `json:"-" gorm:"not null;uniqueIndex:idx_club_invitations_club_user;index"`
+	InvitedByID uint      `json:"-" gorm:"not null"`
+	CreatedAt   time.Time `json:"created_at" gorm:"autoCreateTime"`
+	Club        *Club     `json:"-" gorm:"foreignKey:ClubID"`
+	User        *User     `json:"-" gorm:"foreignKey:UserID"`
+	InvitedBy   *User     `json:"-" gorm:"foreignKey:InvitedByID"`
+}
+
+// ClubInvitationRequest kullanıcı adıyla davet isteği
+type ClubInvitationRequest struct {
+	Username string `json:"username" binding:"required"`
+}
+
+// ClubInvitationResponse davet; kulübün davetlerinde User davet edilen,
+// kullanıcının davetlerinde Club davet edilen kulüptür
+type ClubInvitationResponse struct {
+	ID        uint        `json:"id"`
+	Club      *Club       `json:"club,omitempty"`
+	User      *PublicUser `json:"user,omitempty"`
+	InvitedBy PublicUser  `json:"invited_by"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// ClubBook kulübün okuma listesindeki kitap. Kitabı ekleyen üyenin
+// kütüphanesindeki kayda başvurur; başlık ve yazar eklendiği anda kopyalanır,
+// böylece kitap kalıcı olarak silinse de liste ve tartışmalar korunur.
+// Position, upcoming kitapların sırasıdır.
+type ClubBook struct {
+	ID        uint      `json:"id" gorm:"primarykey;autoIncrement"`
+	ClubID    uint      `json:"-" gorm:"not null;index"`
+	BookID    *uint     `json:"book_id" gorm:"index"`
+	AddedByID uint      `json:"-" gorm:"not null"`
+	Title     string    `json:"title" gorm:"size:255;not null"`
+	Author    string    `json:"author" gorm:"size:255;not null"`
+	Status    string    `json:"status" gorm:"size:16;not null;index"`
+	Position  int       `json:"position"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// ClubBookRequest okuma listesine kitap ekleme isteği; kitap isteği yapanın
+// kütüphanesinde olmalıdır
+type ClubBookRequest struct {
+	BookID   uint   `json:"book_id" binding:"required"`
+	Status   string `json:"status" binding:"required,oneof=current upcoming finished"`
+	Position int    `json:"position" binding:"min=0"`
+}
+
+// ClubBookUpdateRequest okuma listesindeki kitabın durumunu veya sırasını değiştirir
+type ClubBookUpdateRequest struct {
+	Status   string `json:"status" binding:"required,oneof=current upcoming finished"`
+	Position int    `json:"position" binding:"min=0"`
+}
+
+// ClubResponse kulüp, isteği yapanın rolü ve okuma listesi özeti
+type ClubResponse struct {
+	Club
+	Role        string     `json:"role"`
+	MemberCount int        `json:"member_count"`
+	CurrentRead *ClubBook  `json:"current_read"`
+	Upcoming    []ClubBook `json:"upcoming,omitempty"`
+}
+
+// ClubPost kulüp kitabı hakkındaki tartışma gönderisi. ParentID verilirse
+// gönderi başka bir gönderiye yanıttır; Spoiler işaretli gönderilerin metni
+// istenmedikçe gizlenir.
+type ClubPost struct {
+	ID         uint      `json:"id" gorm:"primarykey;autoIncrement"`
+	ClubID     uint      `json:"-" gorm:"not null;index"`
+	ClubBookID uint      `json:"club_book_id" gorm:"not null;index"`
+	UserID     uint      `json:"-" gorm:"not null"`
+	ParentID   *uint     `json:"parent_id" gorm:"index"`
+	Body       string    `json:"body" gorm:"type:text;not null"`
+	Spoiler    bool      `json:"spoiler" gorm:"not null;default:false"`
+	CreatedAt  time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+	User       *User     `json:"-" gorm:"foreignKey:UserID"`
+}
+
+// ClubPostRequest gönderi oluşturma isteği
+type ClubPostRequest struct {
+	Body     string `json:"body" binding:"required"`
+	Spoiler  bool   `json:"spoiler"`
+	ParentID *uint  `json:"parent_id"`
+}
+
+// ClubPostUpdateRequest gönderi düzenleme isteği
+type ClubPostUpdateRequest struct {
+	Body    string `json:"body" binding:"required"`
+	Spoiler bool   `json:"spoiler"`
+}
+
+// ClubPostResponse yanıtlarıyla birlikte gönderi. Hidden, spoiler metninin
+// gizlendiğini belirtir.
+type ClubPostResponse struct {
+	ClubPost
+	Hidden  bool               `json:"hidden,omitempty"`
+	User    PublicUser         `json:"user"`
+	Replies []ClubPostResponse `json:"replies"`
+}
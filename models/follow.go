@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+)
+
+// Takip durumları: private hesaplara gönderilen istekler onaylanana kadar pending kalır
+const (
+	FollowPending  = "pending"
+	FollowAccepted = "accepted"
+)
+
+// Follow FollowerID kullanıcısının FolloweeID kullanıcısını takibi
+type Follow struct {
+	ID         uint      `json:"id" gorm:"primarykey;autoIncrement"`
+	FollowerID uint      `json:"-" gorm:"not null;uniqueIndex:idx_follows_pair"`
+	FolloweeID uint      `json:"-" gorm:"not null;uniqueIndex:idx_follows_pair;index"`
+	Status     string    `json:"status" gorm:"size:16;not null"`
+	CreatedAt  time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+	Follower   *User     `json:"-" gorm:"foreignKey:FollowerID"`
+	Followee   *User     `json:"-" gorm:"foreignKey:FolloweeID"`
+}
+
+// FollowResponse takip listelerindeki kayıt; User listeye göre takip eden
+// veya takip edilen kullanıcıdır
+type FollowResponse struct {
+	ID        uint       `json:"id"`
+	Status    string     `json:"status"`
+	User      PublicUser `json:"user"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// FollowSettingsRequest hesap gizliliği ayarı; private hesaplara gönderilen
+// takip istekleri onaylanana kadar bekler. Private hesabın profili, public
+// kitapları ve yorumları yalnızca onaylı takipçilerine görünür; paylaşım
+// bağlantıları ise çalışmaya devam eder.
+type FollowSettingsRequest struct {
+	PrivateAccount *bool `json:"private_account" binding:"required"`
+}
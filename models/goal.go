@@ -0,0 +1,92 @@
+package models
+
+import (
+	"time"
+)
+
+// ReadingGoal kullanıcının bir yıl için okuma hedefi. TargetBooks veya
+// TargetPages 0 ise o hedef belirlenmemiştir. NotifiedMilestone bildirimi
+// gönderilmiş en yüksek ilerleme yüzdesidir.
+type ReadingGoal struct {
+	ID                uint      `json:"id" gorm:"primarykey;autoIncrement"`
+	UserID            uint      `json:"-" gorm:"not null;uniqueIndex:idx_reading_goals_user_year"`
+	Year              int       `json:"year" gorm:"not null;uniqueIndex:idx_reading_goals_user_year"`
+	TargetBooks       int       `json:"target_books"`
+	TargetPages       int       `json:"target_pages"`
+	NotifiedMilestone int       `json:"-" gorm:"not null;default:0"`
+	CreatedAt         time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt         time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// ReadingGoalRequest hedef belirleme isteği; en az bir hedef verilmelidir
+type ReadingGoalRequest struct {
+	TargetBooks int `json:"target_books" binding:"min=0,required_without=TargetPages"`
+	TargetPages int `json:"target_pages" binding:"min=0,required_without=TargetBooks"`
+}
+
+// Challenge türleri
+const (
+	ChallengeBooks           = "books"            // herhangi bir kitap
+	ChallengePages           = "pages"            // toplam sayfa
+	ChallengeNewAuthors      = "new_authors"      // yıl öncesinde okunmamış bir yazarın kitabı
+	ChallengeTag             = "tag"              // Value etiketine sahip kitap
+	ChallengeMinPages        = "min_pages"        // en az Value sayfalık kitap
+	ChallengePublishedBefore = "published_before" // Value yılından önce yayımlanmış kitap
+)
+
+// ReadingChallenge bir yıl içindeki okumalarla değerlendirilen özel hedef,
+// ör. "yeni yazarlardan 5 kitap" (type=new_authors, target=5). Value, türün
+// gerektirdiği parametredir (etiket, sayfa sayısı veya yıl). pages dışındaki
+// türler yıl içinde okunan farklı kitapları sayar.
+type ReadingChallenge struct {
+	ID        uint      `json:"id" gorm:"primarykey;autoIncrement"`
+	UserID    uint      `json:"-" gorm:"not null;index:idx_reading_challenges_user_year"`
+	Year      int       `json:"year" gorm:"not null;index:idx_reading_challenges_user_year"`
+	Name      string    `json:"name" gorm:"size:255;not null"`
+	Type      string    `json:"type" gorm:"size:32;not null"`
+	Target    int       `json:"target" gorm:"not null"`
+	Value     string    `json:"value,omitempty" gorm:"size:255"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// ReadingChallengeRequest challenge oluşturma ve güncelleme isteği
+type ReadingChallengeRequest struct {
+	Name   string `json:"name" binding:"required,max=255"`
+	Type   string `json:"type" binding:"required,oneof=books pages new_authors tag min_pages published_before"`
+	Target int    `json:"target" binding:"required,min=1"`
+	Value  string `json:"value" binding:"max=255"`
+}
+
+// ChallengeProgress challenge ve yıl içindeki ilerlemesi
+type ChallengeProgress struct {
+	ReadingChallenge
+	Progress  int  `json:"progress"`
+	Completed bool `json:"completed"`
+}
+
+// GoalPacing tek bir hedefin (kitap veya sayfa) ilerlemesi. Expected, yılın
+// geçen kısmına göre bugüne kadar okunmuş olması gereken miktardır; Projected
+// mevcut hızla yıl sonunda ulaşılacak miktardır. Status completed, on_track
+// veya behind olur. ProjectedCompletion hedefe ulaşılan (veya mevcut hızla
+// ulaşılacak) tarihtir.
+type GoalPacing struct {
+	Target              int        `json:"target"`
+	Read                int        `json:"read"`
+	Percent             float64    `json:"percent"`
+	Expected            int        `json:"expected"`
+	Projected           int        `json:"projected"`
+	Status              string     `json:"status"`
+	ProjectedCompletion *time.Time `json:"projected_completion"`
+}
+
+// ReadingGoalResponse yılın hedefi, ilerlemesi ve challenge'ları. Hedef
+// belirlenmemişse Books ve Pages boştur.
+type ReadingGoalResponse struct {
+	Year       int                 `json:"year"`
+	BooksRead  int                 `json:"books_read"`
+	PagesRead  int                 `json:"pages_read"`
+	Books      *GoalPacing         `json:"books"`
+	Pages      *GoalPacing         `json:"pages"`
+	Challenges []ChallengeProgress `json:"challenges"`
+}
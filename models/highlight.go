@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+)
+
+// Highlight kitaptan alınmış bir vurgu (ör. Kindle kupürü)
+type Highlight struct {
+	ID            uint       `json:"id" gorm:"primarykey;autoIncrement"`
+	UserID        uint       `json:"-" gorm:"not null;uniqueIndex:idx_highlights_user_hash"`
+	BookID        uint       `json:"book_id" gorm:"not null;index"`
+	Text          string     `json:"text" gorm:"type:text;not null"`
+	Location      string     `json:"location,omitempty" gorm:"size:32"`
+	Page          *int       `json:"page,omitempty"`
+	Note          string     `json:"note,omitempty" gorm:"type:text"`
+	HighlightedAt *time.Time `json:"highlighted_at"`
+	Hash          string     `json:"-" gorm:"size:40;not null;uniqueIndex:idx_highlights_user_hash"`
+	CreatedAt     time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+)
+
+// İçe aktarma işi durumları
+const (
+	ImportJobPending   = "pending"
+	ImportJobRunning   = "running"
+	ImportJobCompleted = "completed"
+	ImportJobFailed    = "failed"
+)
+
+// İçe aktarılan satırların sonuç durumları
+const (
+	ImportRowImported = "imported"
+	ImportRowPreview  = "would_import"
+	ImportRowDup      = "duplicate"
+	ImportRowSkipped  = "skipped"
+	ImportRowError    = "error"
+)
+
+// ImportJob bir içe aktarma işleminin durumu ve satır bazlı raporu
+type ImportJob struct {
+	ID         uint              `json:"id" gorm:"primarykey;autoIncrement"`
+	UserID     uint              `json:"-" gorm:"not null;index"`
+	Source     string            `json:"source" gorm:"size:32;not null"`
+	Status     string            `json:"status" gorm:"size:16;not null"`
+	DryRun     bool              `json:"dry_run"`
+	TotalRows  int               `json:"total_rows"`
+	Imported   int               `json:"imported"`
+	Duplicates int               `json:"duplicates"`
+	Skipped    int               `json:"skipped"`
+	Failed     int               `json:"failed"`
+	Error      string            `json:"error,omitempty" gorm:"type:text"`
+	Results    []ImportRowResult `json:"results" gorm:"serializer:json"`
+	CreatedAt  time.Time         `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time         `json:"updated_at" gorm:"autoUpdateTime"`
+	FinishedAt *time.Time        `json:"finished_at"`
+}
+
+// ImportRowResult içe aktarılan dosyadaki bir satırın sonucu
+type ImportRowResult struct {
+	Row     int    `json:"row"`
+	Title   string `json:"title,omitempty"`
+	Author  string `json:"author,omitempty"`
+	Status  string `json:"status"`
+	BookID  uint   `json:"book_id,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// AddResult satır sonucunu rapora ekler ve sayaçları günceller
+func (j *ImportJob) AddResult(result ImportRowResult) {
+	j.Results = append(j.Results, result)
+	switch result.Status {
+	case ImportRowImported, ImportRowPreview:
+		j.Imported++
+	case ImportRowDup:
+		j.Duplicates++
+	case ImportRowSkipped:
+		j.Skipped++
+	case ImportRowError:
+		j.Failed++
+	}
+}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"time"
+)
+
+// Ödünç kaydı yönleri
+const (
+	LoanLent     = "lent"     // kullanıcı kitabını başkasına verdi
+	LoanBorrowed = "borrowed" // kullanıcı başkasından kitap aldı
+)
+
+// Ödünç hatırlatma aşamaları; her aşama için en fazla bir bildirim gönderilir
+const (
+	LoanReminderNone    = 0
+	LoanReminderDueSoon = 1
+	LoanReminderOverdue = 2
+)
+
+// Loan ödünç verilen veya ödünç alınan bir kitap. Ödünç verilen kitaplar
+// kullanıcının kütüphanesinden seçilir; ödünç alınanlar kütüphanede
+// olmayabileceğinden başlık ve yazar kayda kopyalanır. Karşı taraf bir isim
+// (Name) veya uygulamadaki başka bir kullanıcıdır (ContactID). DueDate boşsa
+// iade tarihi belirlenmemiştir; ReturnedAt boşsa kitap henüz iade edilmemiştir.
+type Loan struct {
+	ID            uint       `json:"id" gorm:"primarykey;autoIncrement"`
+	UserID        uint       `json:"-" gorm:"not null;index"`
+	Direction     string     `json:"direction" gorm:"size:16;not null"`
+	BookID        *uint      `json:"book_id" gorm:"index"`
+	Title         string     `json:"title" gorm:"size:255;not null"`
+	Author        string     `json:"author" gorm:"size:255;not null"`
+	ContactID     *uint      `json:"-" gorm:"index"`
+	Name          string     `json:"name" gorm:"size:100"`
+	LoanedAt      time.Time  `json:"loaned_at" gorm:"not null"`
+	DueDate       *time.Time `json:"due_date"`
+	ReturnedAt    *time.Time `json:"returned_at"`
+	Notes         string     `json:"notes" gorm:"type:text"`
+	ReminderStage int        `json:"-" gorm:"not null;default:0"`
+	CreatedAt     time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+	User          *User      `json:"-" gorm:"foreignKey:UserID"`
+	Contact       *User      `json:"-" gorm:"foreignKey:ContactID"`
+}
+
+// LoanRequest ödünç kaydı oluşturma isteği. Ödünç verilen kitaplar için
+// book_id zorunludur; ödünç alınan kitaplar kütüphanede yoksa başlık ve yazar
+// verilir. Karşı taraf username veya name ile belirtilir.
+type LoanRequest struct {
+	Direction string     `json:"direction" binding:"required,oneof=lent borrowed"`
+	BookID    *uint      `json:"book_id"`
+	Title     string     `json:"title" binding:"max=255"`
+	Author    string     `json:"author" binding:"max=255"`
+	Username  string     `json:"username"`
+	Name      string     `json:"name" binding:"max=100"`
+	LoanedAt  *time.Time `json:"loaned_at"`
+	DueDate   *time.Time `json:"due_date"`
+	Notes     string     `json:"notes"`
+}
+
+// LoanUpdateRequest ödünç kaydını güncelleme isteği; iade tarihi değişirse
+// hatırlatmalar yeni tarihe göre yeniden gönderilir
+type LoanUpdateRequest struct {
+	Name     string     `json:"name" binding:"max=100"`
+	LoanedAt time.Time  `json:"loaned_at" binding:"required"`
+	DueDate  *time.Time `json:"due_date"`
+	Notes    string     `json:"notes"`
+}
+
+// LoanResponse ödünç kaydı, karşı taraftaki kullanıcı ve gecikme durumu
+type LoanResponse struct {
+	Loan
+	Contact *PublicUser `json:"contact,omitempty"`
+	Overdue bool        `json:"overdue"`
+}
+
+// IsOverdue kitabın iade tarihi geçtiği halde iade edilmediğini belirtir
+func (l Loan) IsOverdue(now time.Time) bool {
+	return l.ReturnedAt == nil && l.DueDate != nil && l.DueDate.Before(now)
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"time"
+)
+
+// Bildirim türleri
+const (
+	NotificationBookLiked       = "book_liked"
+	NotificationBookCommented   = "book_commented"
+	NotificationFollowed        = "followed"
+	NotificationFollowRequested = "follow_requested"
+	NotificationFollowAccepted  = "follow_accepted"
+	NotificationGoalMilestone   = "goal_milestone"
+	NotificationImportFinished  = "import_finished"
+	NotificationLoanReminder    = "loan_reminder"
+)
+
+// Notification kullanıcıya gönderilen bildirim. ActorID bildirime yol açan
+// kullanıcı, BookID ve CommentID ilgili kayıtlardır; Message gösterime hazır
+// metindir. ReadAt boşsa bildirim okunmamıştır. HiddenInApp true ise bildirim
+// yalnızca e-posta veya webhook ile iletilir ve listede görünmez;
+// EmailPending ve WebhookPending henüz iletilmemiş kanalları belirtir.
+type Notification struct {
+	ID               uint       `json:"id" gorm:"primarykey;autoIncrement"`
+	UserID           uint       `json:"-" gorm:"not null;index"`
+	Type             string     `json:"type" gorm:"size:32;not null"`
+	ActorID          *uint      `json:"-"`
+	BookID           *uint      `json:"book_id,omitempty" gorm:"index"`
+	CommentID        *uint      `json:"comment_id,omitempty"`
+	Message          string     `json:"message" gorm:"size:512;not null"`
+	ReadAt           *time.Time `json:"read_at"`
+	HiddenInApp      bool       `json:"-" gorm:"not null;default:false"`
+	EmailPending     bool       `json:"-" gorm:"not null;default:false"`
+	WebhookPending   bool       `json:"-" gorm:"not null;default:false"`
+	DeliveryAttempts int        `json:"-" gorm:"not null;default:0"`
+	CreatedAt        time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	User             *User      `json:"-" gorm:"foreignKey:UserID"`
+	Actor            *User      `json:"-" gorm:"foreignKey:ActorID"`
+}
+
+// NotificationResponse bildirim ve bildirime yol açan kullanıcı
+type NotificationResponse struct {
+	Notification
+	Actor *PublicUser `json:"actor,omitempty"`
+}
+
+// NotificationListResponse bildirim sayfası. NextCursor, sonraki sayfa için
+// cursor parametresine verilir; son sayfada boştur.
+type NotificationListResponse struct {
+	Items       []NotificationResponse `json:"items"`
+	UnreadCount int64                  `json:"unread_count"`
+	NextCursor  string                 `json:"next_cursor,omitempty"`
+}
+
+// NotificationPreference kullanıcının bildirim kanalları. Kaydı olmayan
+// kullanıcılar yalnızca uygulama içi bildirim alır. MutedTypes içindeki
+// türler hiçbir kanaldan iletilmez.
+type NotificationPreference struct {
+	ID         uint      `json:"-" gorm:"primarykey;autoIncrement"`
+	UserID     uint      `json:"-" gorm:"not null;uniqueIndex"`
+	InApp      bool      `json:"in_app" gorm:"not null"`
+	Email      bool      `json:"email" gorm:"not null"`
+	Webhook    bool      `json:"webhook" gorm:"not null"`
+	WebhookURL string    `json:"webhook_url" gorm:"size:2048"`
+	MutedTypes []string  `json:"muted_types" gorm:"serializer:json"`
+	CreatedAt  time.Time `json:"-" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// Mutes tür için bildirimlerin kapatılıp kapatılmadığını döner
+func (p NotificationPreference) Mutes(notificationType string) bool {
+	for _, muted := range p.MutedTypes {
+		if muted == notificationType {
+			return true
+		}
+	}
+	return false
+}
+
+// NotificationPreferenceRequest bildirim tercihlerini değiştirme isteği;
+// webhook açıksa webhook_url zorunludur ve https olmalıdır
+type NotificationPreferenceRequest struct {
+	InApp      bool     `json:"in_app"`
+	Email      bool     `json:"email"`
+	Webhook    bool     `json:"webhook"`
+	WebhookURL string   `json:"webhook_url" binding:"omitempty,url,startswith=https://,max=2048"`
+	MutedTypes []string `json:"muted_types" binding:"dive,oneof=book_liked book_commented followed follow_requested follow_accepted goal_milestone import_finished loan_reminder"`
+}
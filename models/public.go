@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+)
+
+// PublicUser herkese açık sayfalarda gösterilen kullanıcı bilgileri
+type PublicUser struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Username  string `json:"username"`
+}
+
+// PublicBookResponse paylaşılan bir kitap incelemesi. Notes yalnızca sahibi
+// notları paylaşmayı seçtiyse doldurulur; okuma geçmişi ve sürüm gösterilmez.
+type PublicBookResponse struct {
+	ID           uint            `json:"id"`
+	Title        string          `json:"title"`
+	Author       string          `json:"author"`
+	Summary      string          `json:"summary"`
+	ReadDate     time.Time       `json:"read_date"`
+	Rating       int             `json:"rating"`
+	Notes        string          `json:"notes,omitempty"`
+	Tags         []string        `json:"tags"`
+	ISBN13       string          `json:"isbn13,omitempty"`
+	PageCount    int             `json:"page_count,omitempty"`
+	PublishYear  int             `json:"publish_year,omitempty"`
+	CoverURL     string          `json:"cover_url,omitempty"`
+	ThumbnailURL string          `json:"thumbnail_url,omitempty"`
+	Series       *SeriesSummary  `json:"series,omitempty"`
+	Authors      []AuthorSummary `json:"authors"`
+	LikeCount    int             `json:"like_count"`
+	CommentCount int             `json:"comment_count"`
+	User         PublicUser      `json:"user"`
+}
+
+// PublicProfileResponse kullanıcının herkese açık profili ve public kitapları
+type PublicProfileResponse struct {
+	User  PublicUser           `json:"user"`
+	Books []PublicBookResponse `json:"books"`
+}
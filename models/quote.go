@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+)
+
+// Quote kitaptan kaydedilmiş bir alıntı
+type Quote struct {
+	ID        uint      `json:"id" gorm:"primarykey;autoIncrement"`
+	UserID    uint      `json:"-" gorm:"not null;index"`
+	BookID    uint      `json:"book_id" gorm:"not null;index"`
+	Text      string    `json:"text" gorm:"type:text;not null"`
+	Page      *int      `json:"page,omitempty"`
+	Tags      []string  `json:"tags" gorm:"serializer:json"`
+	Favorite  bool      `json:"favorite" gorm:"not null;default:false"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// QuoteRequest alıntı ekleme ve güncelleme isteği
+type QuoteRequest struct {
+	Text     string   `json:"text" binding:"required"`
+	Page     *int     `json:"page" binding:"omitempty,min=1"`
+	Tags     []string `json:"tags"`
+	Favorite bool     `json:"favorite"`
+}
+
+// QuoteResponse kitaptan bağımsız listelenen alıntı; kitabın adı ve yazarıyla döner
+type QuoteResponse struct {
+	Quote
+	BookTitle  string `json:"book_title"`
+	BookAuthor string `json:"book_author"`
+}
@@ -0,0 +1,23 @@
+package models
+
+// Öneri kaynakları
+const (
+	RecommendationWishlist = "wishlist" // kullanıcının istek listesi
+	RecommendationPublic   = "public"   // diğer kullanıcıların herkese açık kitapları
+)
+
+// RecommendationResponse önerilen kitap. Kaynak wishlist ise WishlistItemID,
+// public ise BookID, Username ve URL doludur. Reasons puanın gerekçeleridir.
+type RecommendationResponse struct {
+	Source         string   `json:"source"`
+	Title          string   `json:"title"`
+	Author         string   `json:"author"`
+	Tags           []string `json:"tags"`
+	Score          float64  `json:"score"`
+	Reasons        []string `json:"reasons"`
+	WishlistItemID *uint    `json:"wishlist_item_id,omitempty"`
+	BookID         *uint    `json:"book_id,omitempty"`
+	Username       string   `json:"username,omitempty"`
+	AverageRating  float64  `json:"average_rating,omitempty"`
+	URL            string   `json:"url,omitempty"`
+}
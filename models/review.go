@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+)
+
+// BookLike kullanıcının public bir kitap incelemesini beğenmesi
+type BookLike struct {
+	ID        uint      `json:"id" gorm:"primarykey;autoIncrement"`
+	BookID    uint      `json:"-" gorm:"not null;uniqueIndex:idx_book_likes_book_user"`
+	UserID    uint      `json:"-" gorm:"not null;uniqueIndex:idx_book_likes_book_user;index"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime;index"`
+}
+
+// İnceleme işlemi türleri
+const (
+	ReviewActionLike    = "like"
+	ReviewActionComment = "comment"
+)
+
+// ReviewAction kullanıcının beğeni ve yorum işlemlerinin kaydı. Kayıtlar
+// beğeni geri alındığında veya yorum silindiğinde silinmez; istek sınırı ve
+// tekrarlanan beğenilerde bildirimin yeniden gönderilmemesi bu kayıtlara
+// dayanır.
+type ReviewAction struct {
+	ID        uint      `json:"-" gorm:"primarykey;autoIncrement"`
+	UserID    uint      `json:"-" gorm:"not null;index:idx_review_actions_user_action"`
+	Action    string    `json:"-" gorm:"size:16;not null;index:idx_review_actions_user_action"`
+	BookID    uint      `json:"-" gorm:"not null;index"`
+	CreatedAt time.Time `json:"-" gorm:"autoCreateTime;index"`
+}
+
+// BookComment public bir kitap incelemesine yazılan yorum. Yorumu yazarı
+// düzenleyip silebilir, kitabın sahibi silebilir.
+type BookComment struct {
+	ID        uint      `json:"id" gorm:"primarykey;autoIncrement"`
+	BookID    uint      `json:"-" gorm:"not null;index"`
+	UserID    uint      `json:"-" gorm:"not null;index"`
+	Body      string    `json:"body" gorm:"type:text;not null"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime;index"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+	User      *User     `json:"-" gorm:"foreignKey:UserID"`
+}
+
+// BookCommentRequest yorum oluşturma ve düzenleme isteği
+type BookCommentRequest struct {
+	Body string `json:"body" binding:"required,max=2000"`
+}
+
+// BookCommentResponse yorum ve yazarı
+type BookCommentResponse struct {
+	BookComment
+	User PublicUser `json:"user"`
+}
+
+// BookLikeResponse beğeni işleminden sonra kitabın beğeni durumu
+type BookLikeResponse struct {
+	Liked     bool `json:"liked"`
+	LikeCount int  `json:"like_count"`
+}
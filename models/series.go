@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+)
+
+// Series bir kitap serisi. TotalBooks 0 ise serideki kitap sayısı bilinmiyor
+// demektir. Kütüphanedeki kitaplar okunmuş sayıldığından okunmamış kitaplar,
+// 1..TotalBooks arasında kütüphanede karşılığı olmayan sıralardır.
+type Series struct {
+	ID         uint      `json:"id" gorm:"primarykey;autoIncrement"`
+	UserID     uint      `json:"-" gorm:"not null;index"`
+	Name       string    `json:"name" binding:"required,max=255" gorm:"size:255;not null"`
+	Author     string    `json:"author" binding:"max=255" gorm:"size:255"`
+	TotalBooks int       `json:"total_books" binding:"min=0,max=10000"`
+	CreatedAt  time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+	Books      []Book    `json:"-" gorm:"foreignKey:SeriesID"`
+}
+
+// SeriesSummary kitap detayında gösterilen seri bilgisi
+type SeriesSummary struct {
+	ID       uint     `json:"id"`
+	Name     string   `json:"name"`
+	Position *float64 `json:"position,omitempty"`
+}
+
+// SeriesBook seri detayında sırasıyla listelenen kitap
+type SeriesBook struct {
+	ID       uint     `json:"id"`
+	Title    string   `json:"title"`
+	Author   string   `json:"author"`
+	Rating   int      `json:"rating"`
+	Position *float64 `json:"position"`
+}
+
+// SeriesResponse seri ve okuma durumu. UnreadCount yalnızca TotalBooks
+// biliniyorsa doldurulur; NextPosition okunacak sıradaki kitabın sırasıdır.
+type SeriesResponse struct {
+	ID           uint         `json:"id"`
+	Name         string       `json:"name"`
+	Author       string       `json:"author,omitempty"`
+	TotalBooks   int          `json:"total_books"`
+	ReadCount    int          `json:"read_count"`
+	UnreadCount  *int         `json:"unread_count"`
+	NextPosition *int         `json:"next_position"`
+	Books        []SeriesBook `json:"books,omitempty"`
+	CreatedAt    time.Time    `json:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at"`
+}
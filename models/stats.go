@@ -0,0 +1,66 @@
+package models
+
+// PeriodStats bir ay ("2024-03") veya yıl ("2024") içinde bitirilen okumalar.
+// Sayfa sayısı bilinmeyen kitaplar Pages toplamına katılmaz.
+type PeriodStats struct {
+	Period        string   `json:"period"`
+	Books         int      `json:"books"`
+	Pages         int      `json:"pages"`
+	AverageRating *float64 `json:"average_rating"`
+}
+
+// RatingCount puan dağılımındaki bir puanın okuma sayısı
+type RatingCount struct {
+	Rating int `json:"rating"`
+	Count  int `json:"count"`
+}
+
+// AuthorCount en çok okunan yazarlardaki bir yazar
+type AuthorCount struct {
+	ID    uint   `json:"id"`
+	Name  string `json:"name"`
+	Books int    `json:"books"`
+}
+
+// StatsBook en uzun/en kısa kitap bilgisi
+type StatsBook struct {
+	ID        uint   `json:"id"`
+	Title     string `json:"title"`
+	Author    string `json:"author"`
+	PageCount int    `json:"page_count"`
+}
+
+// ReadingStreak art arda okuma yapılan günler veya aylar; Start ve End dahildir
+type ReadingStreak struct {
+	Length int    `json:"length"`
+	Start  string `json:"start"`
+	End    string `json:"end"`
+}
+
+// ReadingStreaks okuma serileri. Başlangıç tarihi bilinen okumalarda başlangıç
+// ile bitiş arasındaki her gün okuma günü sayılır, diğerlerinde yalnızca bitiş günü.
+// Current değerleri bugün (veya aralığın sonu) ya da bir önceki günde/ayda biten serilerdir.
+type ReadingStreaks struct {
+	LongestDays   *ReadingStreak `json:"longest_days"`
+	CurrentDays   int            `json:"current_days"`
+	LongestMonths *ReadingStreak `json:"longest_months"`
+	CurrentMonths int            `json:"current_months"`
+}
+
+// ReadingStatsResponse okuma istatistikleri. Her okuma (yeniden okumalar dahil)
+// bitiş tarihine göre sayılır; puanı 0 olan okumalar ortalamalara katılmaz.
+type ReadingStatsResponse struct {
+	From               *string        `json:"from"`
+	To                 *string        `json:"to"`
+	TotalBooks         int            `json:"total_books"`
+	UniqueBooks        int            `json:"unique_books"`
+	TotalPages         int            `json:"total_pages"`
+	AverageRating      *float64       `json:"average_rating"`
+	BooksPerYear       []PeriodStats  `json:"books_per_year"`
+	BooksPerMonth      []PeriodStats  `json:"books_per_month"`
+	RatingDistribution []RatingCount  `json:"rating_distribution"`
+	TopAuthors         []AuthorCount  `json:"top_authors"`
+	LongestBook        *StatsBook     `json:"longest_book"`
+	ShortestBook       *StatsBook     `json:"shortest_book"`
+	Streaks            ReadingStreaks `json:"streaks"`
+}
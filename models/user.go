@@ -8,15 +8,17 @@ import (
 )
 
 type User struct {
-	ID        uint           `json:"id" gorm:"primarykey;autoIncrement"`
-	FirstName string         `json:"first_name" binding:"required" form:"first_name"`
-	LastName  string         `json:"last_name" binding:"required" form:"last_name"`
-	Username  string         `json:"username" binding:"required" gorm:"unique" form:"username"`
-	Password  string         `json:"password" binding:"required" form:"password"`
-	Email     string         `json:"email" binding:"required,email" gorm:"unique" form:"email"`
-	CreatedAt time.Time      `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
-	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+	ID                 uint           `json:"id" gorm:"primarykey;autoIncrement"`
+	FirstName          string         `json:"first_name" binding:"required" form:"first_name"`
+	LastName           string         `json:"last_name" binding:"required" form:"last_name"`
+	Username           string         `json:"username" binding:"required" gorm:"unique" form:"username"`
+	Password           string         `json:"password" binding:"required" form:"password"`
+	Email              string         `json:"email" binding:"required,email" gorm:"unique" form:"email"`
+	PrivateAccount     bool           `json:"private_account" gorm:"not null;default:false" form:"private_account"`
+	WishlistShareToken *string        `json:"-" gorm:"size:32;uniqueIndex"`
+	CreatedAt          time.Time      `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt          time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
+	DeletedAt          gorm.DeletedAt `json:"-" gorm:"index"`
 }
 
 func (u *User) HashPassword() error {
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"time"
+)
+
+// İstek listesi öncelikleri
+const (
+	WishlistPriorityLow    = "low"
+	WishlistPriorityMedium = "medium"
+	WishlistPriorityHigh   = "high"
+)
+
+// WishlistItem kullanıcının edinmek istediği, henüz okumadığı bir kitap.
+// Notes nereden alınabileceğine dair notlardır ve paylaşılan listede
+// görünür. EstimatedPrice boşsa fiyat bilinmiyordur. Kütüphaneye taşınan
+// öğe Book olarak oluşturulur ve listeden silinir.
+type WishlistItem struct {
+	ID             uint      `json:"id" gorm:"primarykey;autoIncrement"`
+	UserID         uint      `json:"-" gorm:"not null;index"`
+	Title          string    `json:"title" gorm:"size:255;not null"`
+	Author         string    `json:"author" gorm:"size:255;not null"`
+	Summary        string    `json:"summary" gorm:"type:text"`
+	Tags           []string  `json:"tags" gorm:"serializer:json"`
+	ISBN10         string    `json:"isbn10" gorm:"size:10"`
+	ISBN13         string    `json:"isbn13" gorm:"size:13"`
+	PageCount      int       `json:"page_count"`
+	PublishYear    int       `json:"publish_year"`
+	Priority       string    `json:"priority" gorm:"size:8;not null;default:medium"`
+	Notes          string    `json:"notes" gorm:"type:text"`
+	EstimatedPrice *float64  `json:"estimated_price"`
+	Currency       string    `json:"currency" gorm:"size:3"`
+	CreatedAt      time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// WishlistItemRequest istek listesine ekleme ve güncelleme isteği; boş
+// öncelik medium sayılır
+type WishlistItemRequest struct {
+	Title          string   `json:"title" binding:"required,max=255"`
+	Author         string   `json:"author" binding:"required,max=255"`
+	Summary        string   `json:"summary"`
+	Tags           []string `json:"tags" binding:"omitempty,dive,min=1,max=64"`
+	ISBN10         string   `json:"isbn10"`
+	ISBN13         string   `json:"isbn13"`
+	PageCount      int      `json:"page_count" binding:"min=0"`
+	PublishYear    int      `json:"publish_year" binding:"omitempty,min=1,max=9999"`
+	Priority       string   `json:"priority" binding:"omitempty,oneof=low medium high"`
+	Notes          string   `json:"notes"`
+	EstimatedPrice *float64 `json:"estimated_price" binding:"omitempty,min=0"`
+	Currency       string   `json:"currency" binding:"omitempty,len=3,alpha"`
+}
+
+// WishlistMoveRequest istek listesindeki kitabı kütüphaneye taşıma isteği.
+// Okuma bilgileri zorunludur; summary boşsa öğedeki özet kullanılır.
+type WishlistMoveRequest struct {
+	ReadDate   time.Time `json:"read_date" binding:"required"`
+	Rating     int       `json:"rating" binding:"required,min=1,max=5"`
+	Summary    string    `json:"summary"`
+	Notes      string    `json:"notes"`
+	Visibility string    `json:"visibility" binding:"omitempty,oneof=private unlisted public"`
+}
+
+// WishlistShareResponse istek listesinin paylaşım bağlantısı
+type WishlistShareResponse struct {
+	ShareURL string `json:"share_url"`
+}
+
+// SharedWishlistResponse paylaşım bağlantısıyla görüntülenen istek listesi
+type SharedWishlistResponse struct {
+	User  PublicUser     `json:"user"`
+	Items []WishlistItem `json:"items"`
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"go-api/controllers"
+	"go-api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupAuthorRoutes(router *gin.Engine) {
+	authors := router.Group("/api/authors")
+	authors.Use(middleware.AuthMiddleware())
+	{
+		authors.GET("", controllers.GetAuthors)
+		authors.POST("/:id/merge", controllers.MergeAuthors)
+	}
+}
@@ -12,9 +12,39 @@ func SetupBookRoutes(router *gin.Engine) {
 	books.Use(middleware.AuthMiddleware())
 	{
 		books.POST("", controllers.CreateBook)
+		books.POST("/batch", controllers.CreateBookBatch)
+		books.POST("/lookup", controllers.LookupBook)
+		books.POST("/import", controllers.ImportBooks)
+		books.GET("/import/jobs/:id", controllers.GetImportJob)
+		books.POST("/highlights/import", controllers.ImportKindleClippings)
 		books.GET("", controllers.GetBooks)
+		books.GET("/trash", controllers.GetTrashedBooks)
+		books.GET("/export", controllers.ExportBooks)
+		books.GET("/duplicates", controllers.GetBookDuplicates)
 		books.GET("/:id", controllers.GetBook)
 		books.PUT("/:id", controllers.UpdateBook)
+		books.PATCH("/:id", controllers.PatchBook)
 		books.DELETE("/:id", controllers.DeleteBook)
+		books.POST("/:id/restore", controllers.RestoreBook)
+		books.PUT("/:id/cover", controllers.UploadBookCover)
+		books.DELETE("/:id/cover", controllers.DeleteBookCover)
+		books.POST("/:id/share", controllers.RotateBookShareLink)
+		books.POST("/:id/merge", controllers.MergeBooks)
+
+		books.GET("/:id/revisions", controllers.GetBookRevisions)
+		books.GET("/:id/revisions/diff", controllers.GetBookRevisionDiff)
+		books.POST("/:id/revisions/:rev/restore", controllers.RestoreBookRevision)
+
+		books.GET("/:id/reads", controllers.GetBookReads)
+		books.POST("/:id/reads", controllers.CreateBookRead)
+		books.PUT("/:id/reads/:readId", controllers.UpdateBookRead)
+		books.DELETE("/:id/reads/:readId", controllers.DeleteBookRead)
+
+		books.GET("/:id/highlights", controllers.GetBookHighlights)
+
+		books.GET("/:id/quotes", controllers.GetBookQuotes)
+		books.POST("/:id/quotes", controllers.CreateBookQuote)
+		books.PUT("/:id/quotes/:quoteId", controllers.UpdateBookQuote)
+		books.DELETE("/:id/quotes/:quoteId", controllers.DeleteBookQuote)
 	}
 }
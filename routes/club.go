@@ -0,0 +1,43 @@
+package routes
+
+import (
+	"go-api/controllers"
+	"go-api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupClubRoutes(router *gin.Engine) {
+	clubs := router.Group("/api/clubs")
+	clubs.Use(middleware.AuthMiddleware())
+	{
+		clubs.GET("", controllers.GetClubs)
+		clubs.POST("", controllers.CreateClub)
+		clubs.GET("/invitations", controllers.GetMyClubInvitations)
+		clubs.POST("/invitations/:invitationId/accept", controllers.AcceptClubInvitation)
+		clubs.DELETE("/invitations/:invitationId", controllers.DeclineClubInvitation)
+	}
+
+	// Kulübe ait tüm yollar üyelik gerektirir
+	club := clubs.Group("/:id")
+	club.Use(middleware.ClubMemberMiddleware())
+	{
+		club.GET("", controllers.GetClub)
+		club.PUT("", controllers.UpdateClub)
+		club.DELETE("", controllers.DeleteClub)
+		club.GET("/members", controllers.GetClubMembers)
+		club.PUT("/members/:userId", controllers.UpdateClubMember)
+		club.DELETE("/members/:userId", controllers.RemoveClubMember)
+		club.GET("/invitations", controllers.GetClubInvitations)
+		club.POST("/invitations", controllers.CreateClubInvitation)
+		club.DELETE("/invitations/:invitationId", controllers.CancelClubInvitation)
+		club.GET("/books", controllers.GetClubBooks)
+		club.POST("/books", controllers.AddClubBook)
+		club.PUT("/books/:bookId", controllers.UpdateClubBook)
+		club.DELETE("/books/:bookId", controllers.RemoveClubBook)
+		club.GET("/books/:bookId/posts", controllers.GetClubBookPosts)
+		club.POST("/books/:bookId/posts", controllers.CreateClubBookPost)
+		club.PUT("/posts/:postId", controllers.UpdateClubPost)
+		club.DELETE("/posts/:postId", controllers.DeleteClubPost)
+	}
+}
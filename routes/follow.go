@@ -0,0 +1,34 @@
+package routes
+
+import (
+	"go-api/controllers"
+	"go-api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupFollowRoutes(router *gin.Engine) {
+	users := router.Group("/api/users")
+	users.Use(middleware.AuthMiddleware())
+	{
+		users.POST("/:username/follow", controllers.FollowUser)
+		users.DELETE("/:username/follow", controllers.UnfollowUser)
+	}
+
+	follows := router.Group("/api/follows")
+	follows.Use(middleware.AuthMiddleware())
+	{
+		follows.GET("/followers", controllers.GetFollowers)
+		follows.GET("/following", controllers.GetFollowing)
+		follows.GET("/requests", controllers.GetFollowRequests)
+		follows.POST("/requests/:id/approve", controllers.ApproveFollowRequest)
+		follows.DELETE("/requests/:id", controllers.RemoveFollower)
+		follows.PUT("/settings", controllers.UpdateFollowSettings)
+	}
+
+	feed := router.Group("/api/feed")
+	feed.Use(middleware.AuthMiddleware())
+	{
+		feed.GET("", controllers.GetFeed)
+	}
+}
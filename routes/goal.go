@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"go-api/controllers"
+	"go-api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupGoalRoutes(router *gin.Engine) {
+	goals := router.Group("/api/goals")
+	goals.Use(middleware.AuthMiddleware())
+	{
+		goals.GET("", controllers.GetGoals)
+		goals.GET("/:year", controllers.GetGoal)
+		goals.PUT("/:year", controllers.SetGoal)
+		goals.DELETE("/:year", controllers.DeleteGoal)
+		goals.POST("/:year/challenges", controllers.CreateChallenge)
+		goals.PUT("/:year/challenges/:id", controllers.UpdateChallenge)
+		goals.DELETE("/:year/challenges/:id", controllers.DeleteChallenge)
+	}
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"go-api/controllers"
+	"go-api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupLoanRoutes(router *gin.Engine) {
+	loans := router.Group("/api/loans")
+	loans.Use(middleware.AuthMiddleware())
+	{
+		loans.GET("", controllers.GetLoans)
+		loans.POST("", controllers.CreateLoan)
+		loans.GET("/:id", controllers.GetLoan)
+		loans.PUT("/:id", controllers.UpdateLoan)
+		loans.POST("/:id/return", controllers.ReturnLoan)
+		loans.DELETE("/:id", controllers.DeleteLoan)
+	}
+}
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"go-api/controllers"
+	"go-api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupNotificationRoutes(router *gin.Engine) {
+	notifications := router.Group("/api/notifications")
+	notifications.Use(middleware.AuthMiddleware())
+	{
+		notifications.GET("", controllers.GetNotifications)
+		notifications.POST("/read-all", controllers.MarkAllNotificationsRead)
+		notifications.POST("/:id/read", controllers.MarkNotificationRead)
+		notifications.GET("/preferences", controllers.GetNotificationPreferences)
+		notifications.PUT("/preferences", controllers.UpdateNotificationPreferences)
+	}
+}
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"go-api/controllers"
+	"go-api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupPublicRoutes(router *gin.Engine) {
+	public := router.Group("/api")
+	public.Use(middleware.OptionalAuthMiddleware())
+	{
+		public.GET("/users/:username/books", controllers.GetPublicProfileBooks)
+		public.GET("/users/:username/books/:id", controllers.GetPublicBook)
+		public.GET("/users/:username/books/:id/comments", controllers.GetBookComments)
+		public.GET("/shared/books/:token", controllers.GetSharedBook)
+		public.GET("/shared/wishlists/:token", controllers.GetSharedWishlist)
+	}
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"go-api/controllers"
+	"go-api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupQuoteRoutes(router *gin.Engine) {
+	quotes := router.Group("/api/quotes")
+	quotes.Use(middleware.AuthMiddleware())
+	{
+		quotes.GET("", controllers.GetQuotes)
+		quotes.GET("/daily", controllers.GetDailyQuote)
+	}
+}
@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"go-api/controllers"
+	"go-api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupRecommendationRoutes(router *gin.Engine) {
+	recommendations := router.Group("/api/recommendations")
+	recommendations.Use(middleware.AuthMiddleware())
+	{
+		recommendations.GET("", controllers.GetRecommendations)
+	}
+}
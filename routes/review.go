@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"go-api/controllers"
+	"go-api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupReviewRoutes(router *gin.Engine) {
+	reviews := router.Group("/api/users/:username/books/:id")
+	reviews.Use(middleware.AuthMiddleware())
+	{
+		reviews.POST("/like", controllers.LikeBook)
+		reviews.DELETE("/like", controllers.UnlikeBook)
+		reviews.POST("/comments", controllers.CreateBookComment)
+		reviews.PUT("/comments/:commentId", controllers.UpdateBookComment)
+		reviews.DELETE("/comments/:commentId", controllers.DeleteBookComment)
+	}
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"go-api/controllers"
+	"go-api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupSeriesRoutes(router *gin.Engine) {
+	series := router.Group("/api/series")
+	series.Use(middleware.AuthMiddleware())
+	{
+		series.GET("", controllers.GetSeries)
+		series.POST("", controllers.CreateSeries)
+		series.GET("/next", controllers.GetNextInSeries)
+		series.GET("/:id", controllers.GetSeriesDetail)
+		series.PUT("/:id", controllers.UpdateSeries)
+		series.DELETE("/:id", controllers.DeleteSeries)
+	}
+}
@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"go-api/controllers"
+	"go-api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupStatsRoutes(router *gin.Engine) {
+	stats := router.Group("/api/stats")
+	stats.Use(middleware.AuthMiddleware())
+	{
+		stats.GET("", controllers.GetReadingStats)
+	}
+}
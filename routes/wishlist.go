@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"go-api/controllers"
+	"go-api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupWishlistRoutes(router *gin.Engine) {
+	wishlist := router.Group("/api/wishlist")
+	wishlist.Use(middleware.AuthMiddleware())
+	{
+		wishlist.GET("", controllers.GetWishlist)
+		wishlist.POST("", controllers.CreateWishlistItem)
+		wishlist.POST("/share", controllers.ShareWishlist)
+		wishlist.DELETE("/share", controllers.UnshareWishlist)
+		wishlist.GET("/:id", controllers.GetWishlistItem)
+		wishlist.PUT("/:id", controllers.UpdateWishlistItem)
+		wishlist.DELETE("/:id", controllers.DeleteWishlistItem)
+		wishlist.POST("/:id/move", controllers.MoveWishlistItem)
+	}
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// authorSeparatorPattern birden fazla yazarı ayıran ifadeler
+var authorSeparatorPattern = regexp.MustCompile(`(?i)\s*(?:;|&|\s+and\s+|\s+ve\s+)\s*`)
+
+// SplitAuthorNames serbest metin yazar alanını yazar adlarına ayırır. Yazarlar
+// ";", "&", "and" veya "ve" ile ayrılır. Tek virgül "Soyad, Ad" biçimi
+// sayılır ve "Ad Soyad" olarak döner (ör. "Le Guin, Ursula K."); yalnızca
+// iki veya daha fazla virgülle ayrılmış listeler ayrı yazarlara bölünür.
+func SplitAuthorNames(value string) []string {
+	var names []string
+	for _, part := range authorSeparatorPattern.Split(value, -1) {
+		commaParts := strings.Split(part, ",")
+		if len(commaParts) == 2 {
+			commaParts = []string{strings.TrimSpace(commaParts[1]) + " " + strings.TrimSpace(commaParts[0])}
+		}
+		for _, name := range commaParts {
+			if name = strings.Join(strings.Fields(name), " "); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// AuthorNameKey yazar adını büyük/küçük harf, noktalama ve boşluk farklarından
+// arındırarak karşılaştırma anahtarı üretir
+func AuthorNameKey(name string) string {
+	var builder strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitAuthorNames(t *testing.T) {
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{"Frank Herbert", []string{"Frank Herbert"}},
+		{"Herbert, Frank", []string{"Frank Herbert"}},
+		{"Le Guin, Ursula K.", []string{"Ursula K. Le Guin"}},
+		{"Neil Gaiman & Terry Pratchett", []string{"Neil Gaiman", "Terry Pratchett"}},
+		{"Gaiman, Neil; Pratchett, Terry", []string{"Neil Gaiman", "Terry Pratchett"}},
+		{"Oğuz Atay ve Sait Faik", []string{"Oğuz Atay", "Sait Faik"}},
+		{"A B, C D, E F", []string{"A B", "C D", "E F"}},
+		{"  ", nil},
+	}
+	for _, tt := range tests {
+		if got := SplitAuthorNames(tt.value); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SplitAuthorNames(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package utils
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-api/models"
+)
+
+// Desteklenen CSV dışa aktarım kaynakları
+const (
+	BookCSVSourceGoodreads  = "goodreads"
+	BookCSVSourceStoryGraph = "storygraph"
+)
+
+// BookCSVRow CSV dosyasındaki bir satırın kitaba dönüştürülmüş hali
+type BookCSVRow struct {
+	Line  int
+	Book  models.Book
+	Shelf string
+	Err   error
+}
+
+var (
+	htmlBreakPattern = regexp.MustCompile(`(?i)<br\s*/?>`)
+	htmlTagPattern   = regexp.MustCompile(`<[^>]+>`)
+)
+
+// DetectBookCSVSource başlık satırından dosyanın Goodreads veya StoryGraph
+// dışa aktarımı olup olmadığını belirler
+func DetectBookCSVSource(header []string) (string, error) {
+	columns := csvColumns(header)
+	if _, ok := columns["exclusive shelf"]; ok {
+		return BookCSVSourceGoodreads, nil
+	}
+	if _, ok := columns["read status"]; ok {
+		return BookCSVSourceStoryGraph, nil
+	}
+	return "", errors.New("CSV dosyası Goodreads veya StoryGraph dışa aktarımı olarak tanınamadı")
+}
+
+// ParseBookCSV Goodreads veya StoryGraph CSV dışa aktarımını okur. source boşsa
+// başlık satırından otomatik belirlenir. Satır hataları BookCSVRow.Err içinde döner.
+func ParseBookCSV(r io.Reader, source string) (string, []BookCSVRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return "", nil, fmt.Errorf("CSV başlık satırı okunamadı: %w", err)
+	}
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	if source == "" {
+		if source, err = DetectBookCSVSource(header); err != nil {
+			return "", nil, err
+		}
+	}
+
+	var parse func(record map[string]string) (models.Book, string, error)
+	switch source {
+	case BookCSVSourceGoodreads:
+		parse = parseGoodreadsRecord
+	case BookCSVSourceStoryGraph:
+		parse = parseStoryGraphRecord
+	default:
+		return "", nil, fmt.Errorf("desteklenmeyen kaynak: %q", source)
+	}
+
+	columns := csvColumns(header)
+	var rows []BookCSVRow
+	for line := 2; ; line++ {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			rows = append(rows, BookCSVRow{Line: line, Err: err})
+			continue
+		}
+
+		record := map[string]string{}
+		for name, index := range columns {
+			if index < len(fields) {
+				record[name] = strings.TrimSpace(fields[index])
+			}
+		}
+
+		book, shelf, err := parse(record)
+		rows = append(rows, BookCSVRow{Line: line, Book: book, Shelf: shelf, Err: err})
+	}
+	return source, rows, nil
+}
+
+func parseGoodreadsRecord(record map[string]string) (models.Book, string, error) {
+	book := models.Book{
+		Title:   record["title"],
+		Author:  record["author"],
+		Summary: cleanReviewText(record["my review"]),
+		Notes:   cleanReviewText(record["private notes"]),
+	}
+	shelf := record["exclusive shelf"]
+	book.ISBN10 = csvISBN(record["isbn"])
+	book.ISBN13 = csvISBN(record["isbn13"])
+	book.PageCount, _ = strconv.Atoi(record["number of pages"])
+	for _, column := range []string{"original publication year", "year published"} {
+		if year, err := strconv.Atoi(record[column]); err == nil && year > 0 {
+			book.PublishYear = year
+			break
+		}
+	}
+
+	// Exclusive shelf zaten durum olarak tutulur, diğer raflar etiket olur
+	for _, name := range strings.Split(record["bookshelves"], ",") {
+		name = strings.TrimSpace(name)
+		if name != "" && name != shelf {
+			book.Tags = append(book.Tags, name)
+		}
+	}
+
+	if rating := record["my rating"]; rating != "" {
+		value, err := strconv.Atoi(rating)
+		if err != nil {
+			return book, shelf, fmt.Errorf("geçersiz puan: %q", rating)
+		}
+		book.Rating = value
+	}
+
+	// Okuma tarihi yoksa eklenme tarihi kullanılır
+	date := record["date read"]
+	if date == "" {
+		date = record["date added"]
+	}
+	if date != "" {
+		readDate, err := parseCSVDate(date)
+		if err != nil {
+			return book, shelf, err
+		}
+		book.ReadDate = readDate
+	}
+
+	return book, shelf, nil
+}
+
+func parseStoryGraphRecord(record map[string]string) (models.Book, string, error) {
+	book := models.Book{
+		Title:   record["title"],
+		Author:  storyGraphAuthors(record["authors"]),
+		Summary: cleanReviewText(record["review"]),
+	}
+	shelf := record["read status"]
+
+	// ISBN/UID sütunu ISBN'i olmayan kitaplar için StoryGraph kimliği içerebilir
+	if isbn := csvISBN(record["isbn/uid"]); len(isbn) == 13 {
+		book.ISBN13 = isbn
+	} else {
+		book.ISBN10 = isbn
+	}
+
+	for _, column := range []string{"tags", "moods"} {
+		for _, name := range strings.Split(record[column], ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				book.Tags = append(book.Tags, name)
+			}
+		}
+	}
+
+	// StoryGraph yarım puanlara izin verir; en yakın tam sayıya yuvarlanır
+	if rating := record["star rating"]; rating != "" {
+		value, err := strconv.ParseFloat(rating, 64)
+		if err != nil {
+			return book, shelf, fmt.Errorf("geçersiz puan: %q", rating)
+		}
+		book.Rating = int(math.Round(value))
+	}
+
+	date := record["last date read"]
+	if date == "" {
+		date = record["date added"]
+	}
+	if date != "" {
+		readDate, err := parseCSVDate(date)
+		if err != nil {
+			return book, shelf, err
+		}
+		book.ReadDate = readDate
+	}
+
+	return book, shelf, nil
+}
+
+// storyGraphAuthors StoryGraph'in virgülle ayırdığı yazar listesini "&" ile
+// birleştirir; virgül tek başına "Soyad, Ad" biçimi sayıldığından yazarlar
+// aksi halde tek kişi olarak okunurdu
+func storyGraphAuthors(value string) string {
+	var authors []string
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			authors = append(authors, name)
+		}
+	}
+	return strings.Join(authors, " & ")
+}
+
+// csvISBN CSV hücresindeki ISBN'i normalize eder. Goodreads ISBN'leri ="..."
+// biçiminde yazar; geçersiz değerler boş döner.
+func csvISBN(value string) string {
+	isbn := NormalizeISBN(strings.TrimSuffix(strings.TrimPrefix(value, "=\""), "\""))
+	if ValidISBN10(isbn) || ValidISBN13(isbn) {
+		return isbn
+	}
+	return ""
+}
+
+// csvColumns başlık adlarını küçük harfli anahtarlarla sütun indeksine eşler
+func csvColumns(header []string) map[string]int {
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	return columns
+}
+
+func parseCSVDate(value string) (time.Time, error) {
+	for _, layout := range []string{"2006/01/02", "2006-01-02", "2006/1/2", "01/02/2006", time.RFC3339} {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("geçersiz tarih: %q", value)
+}
+
+// cleanReviewText Goodreads incelemelerindeki HTML etiketlerini düz metne çevirir
+func cleanReviewText(value string) string {
+	value = htmlBreakPattern.ReplaceAllString(value, "\n")
+	value = htmlTagPattern.ReplaceAllString(value, "")
+	return strings.TrimSpace(value)
+}
@@ -0,0 +1,163 @@
+package utils
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const goodreadsFixture = "Book Id,Title,Author,Author l-f,ISBN,ISBN13,My Rating,Number of Pages,Year Published,Original Publication Year,Date Read,Date Added,Bookshelves,Exclusive Shelf,My Review,Private Notes\n" +
+	`234225,Dune,Frank Herbert,"Herbert, Frank","=""0441172717""","=""9780441172719""",5,604,1990,1965,2023/03/05,2022/12/01,"favorites, sci-fi, read",read,"Harika.<br/><br/>Tekrar okunur.",özel not` + "\n" +
+	`44767458,Project Hail Mary,Andy Weir,"Weir, Andy","=""""","=""""",0,496,2021,,,2023/01/15,to-read,to-read,,` + "\n" +
+	`1,Bozuk Tarih,Yazar,"Yazar",,,4,,,,05.03.2023,,,read,,` + "\n" +
+	`2,Bozuk Puan,Yazar,"Yazar",,,beş,,,,2023-03-05,,,read,,` + "\n"
+
+// storyGraphFixture BOM ile başlar; ilk sütun başlık olduğundan BOM atılmazsa
+// başlıklar boş okunur
+const storyGraphFixture = "\ufeffTitle,Authors,Contributors,ISBN/UID,Format,Read Status,Date Added,Last Date Read,Dates Read,Read Count,Moods,Pace,Star Rating,Review,Tags,Owned?\n" +
+	`Good Omens,"Terry Pratchett, Neil Gaiman",,9780060853983,paperback,read,2023/01/02,2023/02/10,2023/02/01-2023/02/10,1,"funny, lighthearted",fast,3.5,Çok eğlenceli,"fantasy, comedy",No` + "\n" +
+	`Dune,Frank Herbert,,0441172717,paperback,read,2022/12/01,,,1,,,4.25,,,No` + "\n" +
+	`Bilinmeyen,Yazar,,c0ffee-uid,ebook,to-read,2023/04/01,,,0,,,,,,No` + "\n" +
+	`Yarım,Yazar,,,ebook,read,2023/04/01,2023/04/02,,1,,,2.5,,,No` + "\n"
+
+func TestParseBookCSVGoodreads(t *testing.T) {
+	source, rows, err := ParseBookCSV(strings.NewReader(goodreadsFixture), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if source != BookCSVSourceGoodreads {
+		t.Fatalf("kaynak %q, want %q", source, BookCSVSourceGoodreads)
+	}
+	if len(rows) != 4 {
+		t.Fatalf("%d satır, want 4", len(rows))
+	}
+
+	dune := rows[0]
+	if dune.Err != nil {
+		t.Fatal(dune.Err)
+	}
+	if dune.Line != 2 || dune.Shelf != "read" {
+		t.Errorf("satır %d, raf %q", dune.Line, dune.Shelf)
+	}
+	book := dune.Book
+	if book.Title != "Dune" || book.Author != "Frank Herbert" {
+		t.Errorf("başlık/yazar %q/%q", book.Title, book.Author)
+	}
+	if book.ISBN10 != "0441172717" || book.ISBN13 != "9780441172719" {
+		t.Errorf("ISBN %q/%q", book.ISBN10, book.ISBN13)
+	}
+	if book.Rating != 5 || book.PageCount != 604 || book.PublishYear != 1965 {
+		t.Errorf("puan %d, sayfa %d, yıl %d", book.Rating, book.PageCount, book.PublishYear)
+	}
+	if !book.ReadDate.Equal(time.Date(2023, 3, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("okuma tarihi %v", book.ReadDate)
+	}
+	if want := []string{"favorites", "sci-fi"}; !reflect.DeepEqual([]string(book.Tags), want) {
+		t.Errorf("etiketler %q, want %q", book.Tags, want)
+	}
+	if book.Summary != "Harika.\n\nTekrar okunur." || book.Notes != "özel not" {
+		t.Errorf("inceleme %q, not %q", book.Summary, book.Notes)
+	}
+
+	// Okunmamış kitapta eklenme tarihi kullanılır, boş ="" ISBN atlanır
+	unread := rows[1]
+	if unread.Err != nil {
+		t.Fatal(unread.Err)
+	}
+	if unread.Shelf != "to-read" || len(unread.Book.Tags) != 0 {
+		t.Errorf("raf %q, etiketler %q", unread.Shelf, unread.Book.Tags)
+	}
+	if unread.Book.ISBN10 != "" || unread.Book.ISBN13 != "" {
+		t.Errorf("ISBN %q/%q, want boş", unread.Book.ISBN10, unread.Book.ISBN13)
+	}
+	if unread.Book.PublishYear != 2021 || unread.Book.Rating != 0 {
+		t.Errorf("yıl %d, puan %d", unread.Book.PublishYear, unread.Book.Rating)
+	}
+	if !unread.Book.ReadDate.Equal(time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("okuma tarihi %v, want eklenme tarihi", unread.Book.ReadDate)
+	}
+
+	if rows[2].Err == nil || !strings.Contains(rows[2].Err.Error(), "tarih") {
+		t.Errorf("geçersiz tarih hatası bekleniyordu: %v", rows[2].Err)
+	}
+	if rows[3].Err == nil || !strings.Contains(rows[3].Err.Error(), "puan") {
+		t.Errorf("geçersiz puan hatası bekleniyordu: %v", rows[3].Err)
+	}
+}
+
+func TestParseBookCSVStoryGraph(t *testing.T) {
+	source, rows, err := ParseBookCSV(strings.NewReader(storyGraphFixture), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if source != BookCSVSourceStoryGraph {
+		t.Fatalf("kaynak %q, want %q", source, BookCSVSourceStoryGraph)
+	}
+	if len(rows) != 4 {
+		t.Fatalf("%d satır, want 4", len(rows))
+	}
+	for _, row := range rows {
+		if row.Err != nil {
+			t.Fatalf("satır %d: %v", row.Line, row.Err)
+		}
+	}
+
+	omens := rows[0].Book
+	if omens.Title != "Good Omens" {
+		t.Errorf("başlık %q", omens.Title)
+	}
+	if omens.Author != "Terry Pratchett & Neil Gaiman" {
+		t.Errorf("yazar %q", omens.Author)
+	}
+	if omens.ISBN13 != "9780060853983" || omens.ISBN10 != "" {
+		t.Errorf("ISBN %q/%q", omens.ISBN10, omens.ISBN13)
+	}
+	if want := []string{"fantasy", "comedy", "funny", "lighthearted"}; !reflect.DeepEqual([]string(omens.Tags), want) {
+		t.Errorf("etiketler %q, want %q", omens.Tags, want)
+	}
+	if !omens.ReadDate.Equal(time.Date(2023, 2, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("okuma tarihi %v", omens.ReadDate)
+	}
+	if rows[0].Shelf != "read" || omens.Summary != "Çok eğlenceli" {
+		t.Errorf("raf %q, inceleme %q", rows[0].Shelf, omens.Summary)
+	}
+
+	// Son okuma tarihi yoksa eklenme tarihi kullanılır
+	dune := rows[1].Book
+	if dune.ISBN10 != "0441172717" || dune.ISBN13 != "" {
+		t.Errorf("ISBN %q/%q", dune.ISBN10, dune.ISBN13)
+	}
+	if !dune.ReadDate.Equal(time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("okuma tarihi %v, want eklenme tarihi", dune.ReadDate)
+	}
+
+	if uid := rows[2].Book; uid.ISBN10 != "" || uid.ISBN13 != "" || uid.Rating != 0 {
+		t.Errorf("StoryGraph kimliği ISBN sayıldı: %q/%q, puan %d", uid.ISBN10, uid.ISBN13, uid.Rating)
+	}
+
+	// Yarım ve çeyrek puanlar en yakın tam sayıya yuvarlanır
+	for i, want := range map[int]int{0: 4, 1: 4, 3: 3} {
+		if got := rows[i].Book.Rating; got != want {
+			t.Errorf("satır %d puanı %d, want %d", rows[i].Line, got, want)
+		}
+	}
+}
+
+func TestParseBookCSVSource(t *testing.T) {
+	if _, _, err := ParseBookCSV(strings.NewReader("Title,Author\nDune,Frank Herbert\n"), ""); err == nil {
+		t.Error("tanınmayan başlıkta hata bekleniyordu")
+	}
+	if _, _, err := ParseBookCSV(strings.NewReader(goodreadsFixture), "librarything"); err == nil {
+		t.Error("desteklenmeyen kaynakta hata bekleniyordu")
+	}
+	if _, _, err := ParseBookCSV(strings.NewReader(""), ""); err == nil {
+		t.Error("boş dosyada hata bekleniyordu")
+	}
+
+	// Kaynak açıkça verildiğinde başlıktan belirlenmez
+	source, rows, err := ParseBookCSV(strings.NewReader(storyGraphFixture), BookCSVSourceStoryGraph)
+	if err != nil || source != BookCSVSourceStoryGraph || len(rows) != 4 {
+		t.Errorf("kaynak %q, %d satır, hata %v", source, len(rows), err)
+	}
+}
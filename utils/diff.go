@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"strings"
+
+	"go-api/models"
+)
+
+// maxDiffComparisons ortak önek ve sonek çıkarıldıktan sonra karşılaştırılacak
+// en fazla satır çifti; aşılırsa değişen bölüm bütünüyle silinmiş ve eklenmiş
+// sayılır
+const maxDiffComparisons = 1 << 22
+
+// DiffLines iki metni satır satır karşılaştırır ve a'yı b'ye dönüştüren
+// satırları en uzun ortak alt dizi ile bulur. Hesap doğrusal bellekle
+// (Hirschberg) yapılır; değişen bölüm çok büyükse en kısa fark yerine
+// bölümün tamamı silinip eklenir. Bir değişiklikte silinen satırlar
+// eklenenlerden önce gelir.
+func DiffLines(a, b string) []models.DiffLine {
+	left, right := splitLines(a), splitLines(b)
+
+	prefix := 0
+	for prefix < len(left) && prefix < len(right) && left[prefix] == right[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(left)-prefix && suffix < len(right)-prefix &&
+		left[len(left)-1-suffix] == right[len(right)-1-suffix] {
+		suffix++
+	}
+
+	diff := []models.DiffLine{}
+	diff = appendLines(diff, models.DiffEqual, left[:prefix])
+	middleLeft, middleRight := left[prefix:len(left)-suffix], right[prefix:len(right)-suffix]
+	if len(middleLeft)*len(middleRight) > maxDiffComparisons {
+		diff = appendLines(diff, models.DiffDelete, middleLeft)
+		diff = appendLines(diff, models.DiffInsert, middleRight)
+	} else {
+		diff = diffMiddle(diff, middleLeft, middleRight)
+	}
+	return appendLines(diff, models.DiffEqual, left[len(left)-suffix:])
+}
+
+// diffMiddle a ile b arasındaki farkı Hirschberg yöntemiyle diff'e ekler:
+// a ikiye bölünür, b ise iki yarının ortak alt dizileri toplamını en büyük
+// yapan noktadan bölünür
+func diffMiddle(diff []models.DiffLine, a, b []string) []models.DiffLine {
+	switch {
+	case len(a) == 0:
+		return appendLines(diff, models.DiffInsert, b)
+	case len(b) == 0:
+		return appendLines(diff, models.DiffDelete, a)
+	case len(a) == 1:
+		for j, line := range b {
+			if line == a[0] {
+				diff = appendLines(diff, models.DiffInsert, b[:j])
+				diff = append(diff, models.DiffLine{Op: models.DiffEqual, Text: line})
+				return appendLines(diff, models.DiffInsert, b[j+1:])
+			}
+		}
+		diff = append(diff, models.DiffLine{Op: models.DiffDelete, Text: a[0]})
+		return appendLines(diff, models.DiffInsert, b)
+	}
+
+	mid := len(a) / 2
+	prefixes := lcsPrefixLengths(a[:mid], b)
+	suffixes := lcsSuffixLengths(a[mid:], b)
+	split := 0
+	for j := range prefixes {
+		if prefixes[j]+suffixes[j] > prefixes[split]+suffixes[split] {
+			split = j
+		}
+	}
+	diff = diffMiddle(diff, a[:mid], b[:split])
+	return diffMiddle(diff, a[mid:], b[split:])
+}
+
+// lcsPrefixLengths her j için a ile b[:j] arasındaki en uzun ortak alt
+// dizinin uzunluğunu döner
+func lcsPrefixLengths(a, b []string) []int {
+	previous, current := make([]int, len(b)+1), make([]int, len(b)+1)
+	for i := range a {
+		for j := 1; j <= len(b); j++ {
+			if a[i] == b[j-1] {
+				current[j] = previous[j-1] + 1
+			} else {
+				current[j] = max(previous[j], current[j-1])
+			}
+		}
+		previous, current = current, previous
+	}
+	return previous
+}
+
+// lcsSuffixLengths her j için a ile b[j:] arasındaki en uzun ortak alt
+// dizinin uzunluğunu döner
+func lcsSuffixLengths(a, b []string) []int {
+	previous, current := make([]int, len(b)+1), make([]int, len(b)+1)
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				current[j] = previous[j+1] + 1
+			} else {
+				current[j] = max(previous[j], current[j+1])
+			}
+		}
+		previous, current = current, previous
+	}
+	return previous
+}
+
+// appendLines satırları aynı işlemle diff'e ekler
+func appendLines(diff []models.DiffLine, op string, lines []string) []models.DiffLine {
+	for _, line := range lines {
+		diff = append(diff, models.DiffLine{Op: op, Text: line})
+	}
+	return diff
+}
+
+// splitLines metni satırlara böler; boş metin satır içermez
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+}
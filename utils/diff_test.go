@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"go-api/models"
+)
+
+// applyDiff farktan eski ve yeni metni geri kurar
+func applyDiff(diff []models.DiffLine) (string, string) {
+	var left, right []string
+	for _, line := range diff {
+		if line.Op != models.DiffInsert {
+			left = append(left, line.Text)
+		}
+		if line.Op != models.DiffDelete {
+			right = append(right, line.Text)
+		}
+	}
+	return strings.Join(left, "\n"), strings.Join(right, "\n")
+}
+
+// lcsLength tam tabloyla en uzun ortak alt dizinin uzunluğunu hesaplar
+func lcsLength(a, b []string) int {
+	table := make([][]int, len(a)+1)
+	for i := range table {
+		table[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				table[i][j] = table[i-1][j-1] + 1
+			} else {
+				table[i][j] = max(table[i-1][j], table[i][j-1])
+			}
+		}
+	}
+	return table[len(a)][len(b)]
+}
+
+func TestDiffLines(t *testing.T) {
+	eq := func(text string) models.DiffLine { return models.DiffLine{Op: models.DiffEqual, Text: text} }
+	del := func(text string) models.DiffLine { return models.DiffLine{Op: models.DiffDelete, Text: text} }
+	ins := func(text string) models.DiffLine { return models.DiffLine{Op: models.DiffInsert, Text: text} }
+
+	tests := []struct {
+		name string
+		a, b string
+		want []models.DiffLine
+	}{
+		{"iki boş metin", "", "", []models.DiffLine{}},
+		{"aynı metin", "a\nb", "a\nb", []models.DiffLine{eq("a"), eq("b")}},
+		{"boştan metne", "", "a\nb", []models.DiffLine{ins("a"), ins("b")}},
+		{"metinden boşa", "a\nb", "", []models.DiffLine{del("a"), del("b")}},
+		{"ortadaki satır değişir", "a\nb\nc", "a\nx\nc", []models.DiffLine{eq("a"), del("b"), ins("x"), eq("c")}},
+		{"satır eklenir", "a\nc", "a\nb\nc", []models.DiffLine{eq("a"), ins("b"), eq("c")}},
+		{"satır silinir", "a\nb\nc", "a\nc", []models.DiffLine{eq("a"), del("b"), eq("c")}},
+		{"CRLF satır sonları", "a\r\nb", "a\nb", []models.DiffLine{eq("a"), eq("b")}},
+	}
+	for _, tt := range tests {
+		if got := DiffLines(tt.a, tt.b); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDiffLinesIsMinimal(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	lines := func() string {
+		parts := make([]string, random.Intn(12))
+		for i := range parts {
+			parts[i] = string(rune('a' + random.Intn(4)))
+		}
+		return strings.Join(parts, "\n")
+	}
+
+	for i := 0; i < 500; i++ {
+		a, b := lines(), lines()
+		diff := DiffLines(a, b)
+		left, right := applyDiff(diff)
+		if left != a || right != b {
+			t.Fatalf("DiffLines(%q, %q) geri kurulamadı: %q, %q", a, b, left, right)
+		}
+		equal := 0
+		for _, line := range diff {
+			if line.Op == models.DiffEqual {
+				equal++
+			}
+		}
+		if want := lcsLength(splitLines(a), splitLines(b)); equal != want {
+			t.Fatalf("DiffLines(%q, %q) %d ortak satır buldu, want %d", a, b, equal, want)
+		}
+	}
+}
+
+func TestDiffLinesLargeInput(t *testing.T) {
+	var a, b []string
+	for i := 0; i < 5000; i++ {
+		a = append(a, "eski "+strconv.Itoa(i))
+		b = append(b, "yeni "+strconv.Itoa(i))
+	}
+	head, tail := "başlık", "son"
+	left := strings.Join(append(append([]string{head}, a...), tail), "\n")
+	right := strings.Join(append(append([]string{head}, b...), tail), "\n")
+
+	// Değişen bölüm sınırı aştığından bütünüyle silinip eklenir
+	diff := DiffLines(left, right)
+	if len(diff) != 2+len(a)+len(b) {
+		t.Fatalf("len(diff) = %d, want %d", len(diff), 2+len(a)+len(b))
+	}
+	if diff[0].Op != models.DiffEqual || diff[1].Op != models.DiffDelete ||
+		diff[len(a)+1].Op != models.DiffInsert || diff[len(diff)-1].Op != models.DiffEqual {
+		t.Fatalf("beklenmeyen fark sırası: %v ... %v", diff[:2], diff[len(diff)-2:])
+	}
+	if gotLeft, gotRight := applyDiff(diff); gotLeft != left || gotRight != right {
+		t.Fatal("büyük fark geri kurulamadı")
+	}
+}
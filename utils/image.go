@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/jpeg"
+	_ "image/png" // image.Decode için PNG çözücüsü
+	"net/http"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // image.Decode için WebP çözücüsü
+)
+
+// maxImagePixels çözülmesine izin verilen en büyük görsel (sıkıştırma bombalarına karşı)
+const maxImagePixels = 40_000_000
+
+// ImageExtensions desteklenen görsel türleri ve dosya uzantıları
+var ImageExtensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/webp": "webp",
+}
+
+// ErrUnsupportedImage görsel desteklenen bir türde değilse veya çözülemezse döner
+var ErrUnsupportedImage = errors.New("yalnızca JPEG, PNG ve WebP görseller desteklenir")
+
+// DetectImageType içeriğin ilk baytlarından görsel türünü belirler; istemcinin
+// gönderdiği Content-Type'a güvenilmez
+func DetectImageType(data []byte) (string, error) {
+	contentType := http.DetectContentType(data)
+	if _, ok := ImageExtensions[contentType]; !ok {
+		return "", ErrUnsupportedImage
+	}
+	return contentType, nil
+}
+
+// MakeThumbnail görseli çözer ve genişliği en fazla maxWidth olacak şekilde
+// küçültülmüş JPEG küçük resim üretir. Daha küçük görseller büyütülmez.
+func MakeThumbnail(data []byte, maxWidth int) ([]byte, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedImage
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxImagePixels {
+		return nil, errors.New("görsel boyutları çok büyük")
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedImage
+	}
+
+	width, height := config.Width, config.Height
+	if width > maxWidth {
+		height = height * maxWidth / width
+		width = maxWidth
+		if height < 1 {
+			height = 1
+		}
+	}
+
+	// Saydam alanlar JPEG'de siyah görünmesin diye beyaz zemine çizilir
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, src.Bounds(), draw.Over, nil)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 80}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+)
+
+// NormalizeISBN ISBN'deki tire ve boşlukları kaldırır, kontrol karakteri X'i büyük harfe çevirir
+func NormalizeISBN(isbn string) string {
+	replacer := strings.NewReplacer("-", "", " ", "", " ", "")
+	return strings.ToUpper(replacer.Replace(strings.TrimSpace(isbn)))
+}
+
+// ValidISBN10 normalize edilmiş ISBN-10'un biçimini ve kontrol basamağını doğrular
+func ValidISBN10(isbn string) bool {
+	if len(isbn) != 10 {
+		return false
+	}
+
+	sum := 0
+	for i, r := range isbn {
+		var digit int
+		switch {
+		case r >= '0' && r <= '9':
+			digit = int(r - '0')
+		case r == 'X' && i == 9:
+			digit = 10
+		default:
+			return false
+		}
+		sum += digit * (10 - i)
+	}
+	return sum%11 == 0
+}
+
+// ValidISBN13 normalize edilmiş ISBN-13'ün biçimini ve kontrol basamağını doğrular
+func ValidISBN13(isbn string) bool {
+	if len(isbn) != 13 {
+		return false
+	}
+
+	sum := 0
+	for i, r := range isbn {
+		if r < '0' || r > '9' {
+			return false
+		}
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += int(r-'0') * weight
+	}
+	return sum%10 == 0
+}
+
+// ISBN10To13 geçerli bir ISBN-10'u 978 önekli ISBN-13'e çevirir
+func ISBN10To13(isbn10 string) string {
+	body := "978" + isbn10[:9]
+	sum := 0
+	for i, r := range body {
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += int(r-'0') * weight
+	}
+	return body + strconv.Itoa((10-sum%10)%10)
+}
+
+// ISBN13To10 978 önekli geçerli bir ISBN-13'ü ISBN-10'a çevirir; diğer
+// önekler için ISBN-10 karşılığı olmadığından boş döner
+func ISBN13To10(isbn13 string) string {
+	if !strings.HasPrefix(isbn13, "978") {
+		return ""
+	}
+
+	body := isbn13[3:12]
+	sum := 0
+	for i, r := range body {
+		sum += int(r-'0') * (10 - i)
+	}
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return body + "X"
+	}
+	return body + strconv.Itoa(check)
+}
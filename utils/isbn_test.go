@@ -0,0 +1,90 @@
+package utils
+
+import "testing"
+
+func TestNormalizeISBN(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"978-0-441-17271-9", "9780441172719"},
+		{" 0 441 17271 7 ", "0441172717"},
+		{"0-8044-2957-x", "080442957X"},
+		{"979 10 323 0569 0", "9791032305690"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := NormalizeISBN(tt.input); got != tt.want {
+			t.Errorf("NormalizeISBN(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestValidISBN10(t *testing.T) {
+	tests := []struct {
+		isbn string
+		want bool
+	}{
+		{"0441172717", true},
+		{"080442957X", true},
+		{"043942089X", true},
+		{"0441172718", false},    // hatalı kontrol basamağı
+		{"X441172717", false},    // X yalnızca son basamakta olabilir
+		{"080442957x", false},    // normalize edilmemiş
+		{"0-441-17271-7", false}, // tireler normalize edilmeli
+		{"044117271", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := ValidISBN10(tt.isbn); got != tt.want {
+			t.Errorf("ValidISBN10(%q) = %v, want %v", tt.isbn, got, tt.want)
+		}
+	}
+}
+
+func TestValidISBN13(t *testing.T) {
+	tests := []struct {
+		isbn string
+		want bool
+	}{
+		{"9780441172719", true},
+		{"9780804429573", true},
+		{"9791032305690", true},
+		{"9780441172710", false},
+		{"978044117271X", false},
+		{"978-0441172719", false},
+		{"978044117271", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := ValidISBN13(tt.isbn); got != tt.want {
+			t.Errorf("ValidISBN13(%q) = %v, want %v", tt.isbn, got, tt.want)
+		}
+	}
+}
+
+func TestISBNConversion(t *testing.T) {
+	tests := []struct {
+		isbn10 string
+		isbn13 string
+	}{
+		{"0441172717", "9780441172719"},
+		{"080442957X", "9780804429573"},
+		{"043942089X", "9780439420891"},
+	}
+	for _, tt := range tests {
+		if got := ISBN10To13(tt.isbn10); got != tt.isbn13 {
+			t.Errorf("ISBN10To13(%q) = %q, want %q", tt.isbn10, got, tt.isbn13)
+		}
+		if got := ISBN13To10(tt.isbn13); got != tt.isbn10 {
+			t.Errorf("ISBN13To10(%q) = %q, want %q", tt.isbn13, got, tt.isbn10)
+		}
+	}
+}
+
+func TestISBN13To10WithoutEquivalent(t *testing.T) {
+	// 979 önekli ISBN'lerin ISBN-10 karşılığı yoktur
+	if got := ISBN13To10("9791032305690"); got != "" {
+		t.Errorf("ISBN13To10(979...) = %q, want empty", got)
+	}
+}
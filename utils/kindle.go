@@ -0,0 +1,149 @@
+package utils
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Kindle kupür türleri
+const (
+	KindleHighlight = "highlight"
+	KindleNote      = "note"
+	KindleBookmark  = "bookmark"
+)
+
+// kindleSeparator My Clippings.txt dosyasında kupürleri ayıran satır
+const kindleSeparator = "=========="
+
+// KindleClipping My Clippings.txt dosyasındaki tek bir kupür
+type KindleClipping struct {
+	Index         int
+	Title         string
+	Author        string
+	Kind          string
+	Page          *int
+	Location      string
+	LocationStart int
+	LocationEnd   int
+	AddedAt       *time.Time
+	Text          string
+}
+
+var (
+	kindleTitlePattern    = regexp.MustCompile(`^(.*)\(([^()]*)\)\s*$`)
+	kindlePagePattern     = regexp.MustCompile(`(?i)page\s+(\d+)`)
+	kindleLocationPattern = regexp.MustCompile(`(?i)loc(?:ation)?\.?\s+(\d+)(?:-(\d+))?`)
+	kindleAddedPattern    = regexp.MustCompile(`(?i)added on\s+(.+)$`)
+)
+
+// kindleDateLayouts ABD ve İngiltere yerel ayarlı Kindle tarih biçimleri
+var kindleDateLayouts = []string{
+	"Monday, January 2, 2006 3:04:05 PM",
+	"Monday, 2 January 2006 15:04:05",
+	"Monday, January 2, 2006, 3:04 PM",
+	"Monday, 2 January 2006, 15:04",
+}
+
+// ParseKindleClippings Kindle My Clippings.txt içeriğini kupürlere ayırır.
+// Biçimi tanınmayan bloklar atlanır.
+func ParseKindleClippings(r io.Reader) ([]KindleClipping, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var clippings []KindleClipping
+	var block []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		line = strings.TrimPrefix(line, "\ufeff")
+		if strings.TrimSpace(line) != kindleSeparator {
+			block = append(block, line)
+			continue
+		}
+
+		if clipping, ok := parseKindleBlock(block); ok {
+			clipping.Index = len(clippings) + 1
+			clippings = append(clippings, clipping)
+		}
+		block = nil
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if clipping, ok := parseKindleBlock(block); ok {
+		clipping.Index = len(clippings) + 1
+		clippings = append(clippings, clipping)
+	}
+	return clippings, nil
+}
+
+func parseKindleBlock(lines []string) (KindleClipping, bool) {
+	// Baştaki boş satırları atla
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	if len(lines) < 2 {
+		return KindleClipping{}, false
+	}
+
+	var clipping KindleClipping
+	clipping.Title, clipping.Author = parseKindleTitle(strings.TrimSpace(lines[0]))
+
+	meta := strings.TrimSpace(lines[1])
+	lower := strings.ToLower(meta)
+	switch {
+	case strings.Contains(lower, "highlight"):
+		clipping.Kind = KindleHighlight
+	case strings.Contains(lower, "note"):
+		clipping.Kind = KindleNote
+	case strings.Contains(lower, "bookmark"):
+		clipping.Kind = KindleBookmark
+	default:
+		return KindleClipping{}, false
+	}
+
+	if match := kindlePagePattern.FindStringSubmatch(meta); match != nil {
+		page, _ := strconv.Atoi(match[1])
+		clipping.Page = &page
+	}
+	if match := kindleLocationPattern.FindStringSubmatch(meta); match != nil {
+		clipping.LocationStart, _ = strconv.Atoi(match[1])
+		clipping.LocationEnd = clipping.LocationStart
+		clipping.Location = match[1]
+		if match[2] != "" {
+			clipping.LocationEnd, _ = strconv.Atoi(match[2])
+			clipping.Location += "-" + match[2]
+		}
+	}
+	if match := kindleAddedPattern.FindStringSubmatch(meta); match != nil {
+		for _, layout := range kindleDateLayouts {
+			if addedAt, err := time.Parse(layout, strings.TrimSpace(match[1])); err == nil {
+				clipping.AddedAt = &addedAt
+				break
+			}
+		}
+	}
+
+	clipping.Text = strings.TrimSpace(strings.Join(lines[2:], "\n"))
+	return clipping, clipping.Title != ""
+}
+
+// parseKindleTitle "Başlık (Yazar)" satırını ayırır. "Soyad, Ad" biçimindeki
+// yazarlar "Ad Soyad" olarak döner.
+func parseKindleTitle(line string) (string, string) {
+	match := kindleTitlePattern.FindStringSubmatch(line)
+	if match == nil {
+		return line, ""
+	}
+
+	title := strings.TrimSpace(match[1])
+	author := strings.TrimSpace(match[2])
+	if parts := strings.Split(author, ","); len(parts) == 2 && !strings.Contains(author, ";") {
+		author = strings.TrimSpace(parts[1]) + " " + strings.TrimSpace(parts[0])
+	}
+	return title, author
+}
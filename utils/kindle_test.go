@@ -0,0 +1,148 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseKindleClippingsSeparators(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			"LF satır sonları",
+			"Kitap A (Yazar)\n- Your Highlight on Location 10-12 | Added on Monday, January 2, 2023 3:04:05 PM\n\nbir\n==========\n" +
+				"Kitap B (Yazar)\n- Your Highlight on Location 20 | Added on Monday, January 2, 2023 3:04:05 PM\n\niki\n==========\n",
+			[]string{"bir", "iki"},
+		},
+		{
+			"CRLF satır sonları ve BOM",
+			"\ufeffKitap A (Yazar)\r\n- Your Highlight on Location 10-12 | Added on Monday, January 2, 2023 3:04:05 PM\r\n\r\nbir\r\n==========\r\n",
+			[]string{"bir"},
+		},
+		{
+			"son ayırıcı olmadan",
+			"Kitap A (Yazar)\n- Your Highlight on Location 10 | Added on Monday, January 2, 2023 3:04:05 PM\n\nbir\n==========\n" +
+				"Kitap B (Yazar)\n- Your Highlight on Location 20 | Added on Monday, January 2, 2023 3:04:05 PM\n\niki",
+			[]string{"bir", "iki"},
+		},
+		{
+			"boşluklu ayırıcı ve boş bloklar",
+			"==========\n\n==========  \nKitap A (Yazar)\n- Your Highlight on Location 10 | Added on Monday, January 2, 2023 3:04:05 PM\n\nbir\niki satır\n==========\n",
+			[]string{"bir\niki satır"},
+		},
+		{
+			"tanınmayan blok atlanır",
+			"Kitap A (Yazar)\n- Something else entirely\n\nbir\n==========\n" +
+				"Kitap B (Yazar)\n- Your Highlight on Location 20 | Added on Monday, January 2, 2023 3:04:05 PM\n\niki\n==========\n",
+			[]string{"iki"},
+		},
+	}
+	for _, tt := range tests {
+		clippings, err := ParseKindleClippings(strings.NewReader(tt.input))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if len(clippings) != len(tt.want) {
+			t.Errorf("%s: %d kupür, want %d", tt.name, len(clippings), len(tt.want))
+			continue
+		}
+		for i, clipping := range clippings {
+			if clipping.Text != tt.want[i] {
+				t.Errorf("%s: kupür %d metni %q, want %q", tt.name, i, clipping.Text, tt.want[i])
+			}
+			if clipping.Index != i+1 {
+				t.Errorf("%s: kupür %d sırası %d", tt.name, i, clipping.Index)
+			}
+		}
+	}
+}
+
+func TestParseKindleClippingsMetadata(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		meta     string
+		title    string
+		author   string
+		kind     string
+		page     int
+		location string
+		start    int
+		end      int
+		added    time.Time
+	}{
+		{
+			"ABD tarih biçimi", "Dune (Frank Herbert)",
+			"- Your Highlight on page 12 | Location 100-105 | Added on Sunday, March 5, 2023 9:07:30 PM",
+			"Dune", "Frank Herbert", KindleHighlight, 12, "100-105", 100, 105,
+			time.Date(2023, 3, 5, 21, 7, 30, 0, time.UTC),
+		},
+		{
+			"İngiltere tarih biçimi", "Dune (Herbert, Frank)",
+			"- Your Highlight at location 100-105 | Added on Sunday, 5 March 2023 21:07:30",
+			"Dune", "Frank Herbert", KindleHighlight, 0, "100-105", 100, 105,
+			time.Date(2023, 3, 5, 21, 7, 30, 0, time.UTC),
+		},
+		{
+			"eski ABD biçimi", "Dune (Frank Herbert)",
+			"- Highlight Loc. 100-105 | Added on Sunday, March 5, 2023, 9:07 PM",
+			"Dune", "Frank Herbert", KindleHighlight, 0, "100-105", 100, 105,
+			time.Date(2023, 3, 5, 21, 7, 0, 0, time.UTC),
+		},
+		{
+			"eski İngiltere biçimi", "Dune (Frank Herbert)",
+			"- Note Loc. 105 | Added on Sunday, 5 March 2023, 21:07",
+			"Dune", "Frank Herbert", KindleNote, 0, "105", 105, 105,
+			time.Date(2023, 3, 5, 21, 7, 0, 0, time.UTC),
+		},
+		{
+			"yer imi ve parantezli başlık", "Dune (Dune Chronicles, Book 1) (Frank Herbert)",
+			"- Your Bookmark on page 7 | Added on Sunday, March 5, 2023 9:07:30 PM",
+			"Dune (Dune Chronicles, Book 1)", "Frank Herbert", KindleBookmark, 7, "", 0, 0,
+			time.Date(2023, 3, 5, 21, 7, 30, 0, time.UTC),
+		},
+		{
+			"yazarsız ve tarihi tanınmayan", "Adsız Belge",
+			"- Your Highlight on Location 3 | Added on 05.03.2023 21:07",
+			"Adsız Belge", "", KindleHighlight, 0, "3", 3, 3,
+			time.Time{},
+		},
+	}
+	for _, tt := range tests {
+		input := tt.header + "\n" + tt.meta + "\n\nmetin\n==========\n"
+		clippings, err := ParseKindleClippings(strings.NewReader(input))
+		if err != nil || len(clippings) != 1 {
+			t.Errorf("%s: %d kupür, hata %v", tt.name, len(clippings), err)
+			continue
+		}
+		clipping := clippings[0]
+		if clipping.Title != tt.title || clipping.Author != tt.author {
+			t.Errorf("%s: başlık/yazar %q/%q, want %q/%q", tt.name, clipping.Title, clipping.Author, tt.title, tt.author)
+		}
+		if clipping.Kind != tt.kind {
+			t.Errorf("%s: tür %q, want %q", tt.name, clipping.Kind, tt.kind)
+		}
+		page := 0
+		if clipping.Page != nil {
+			page = *clipping.Page
+		}
+		if page != tt.page {
+			t.Errorf("%s: sayfa %d, want %d", tt.name, page, tt.page)
+		}
+		if clipping.Location != tt.location || clipping.LocationStart != tt.start || clipping.LocationEnd != tt.end {
+			t.Errorf("%s: konum %q (%d-%d), want %q (%d-%d)", tt.name,
+				clipping.Location, clipping.LocationStart, clipping.LocationEnd, tt.location, tt.start, tt.end)
+		}
+		switch {
+		case tt.added.IsZero() && clipping.AddedAt != nil:
+			t.Errorf("%s: tarih %v, want nil", tt.name, *clipping.AddedAt)
+		case !tt.added.IsZero() && (clipping.AddedAt == nil || !clipping.AddedAt.Equal(tt.added)):
+			t.Errorf("%s: tarih %v, want %v", tt.name, clipping.AddedAt, tt.added)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Mailer e-posta gönderen arka uç
+type Mailer interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// formatMail alıcı, konu ve gövdeden düz metin e-posta mesajı üretir
+func formatMail(from, to, subject, body string, date time.Time) string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "From: %s\r\n", from)
+	fmt.Fprintf(&builder, "To: %s\r\n", to)
+	fmt.Fprintf(&builder, "Subject: %s\r\n", subject)
+	fmt.Fprintf(&builder, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	builder.WriteString("MIME-Version: 1.0\r\n")
+	builder.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	builder.WriteString(body)
+	builder.WriteString("\r\n")
+	return builder.String()
+}
+
+// SMTPMailer e-postaları bir SMTP sunucusu üzerinden gönderir. Username boşsa
+// kimlik doğrulama yapılmaz.
+type SMTPMailer struct {
+	Addr     string
+	From     string
+	Username string
+	Password string
+}
+
+// NewSMTPMailer SMTP gönderici döner; addr "host:port" biçimindedir
+func NewSMTPMailer(addr, from, username, password string) (*SMTPMailer, error) {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		return nil, fmt.Errorf("geçersiz SMTP adresi %q: %w", addr, err)
+	}
+	if from == "" {
+		return nil, fmt.Errorf("gönderen adresi boş olamaz")
+	}
+	return &SMTPMailer{Addr: addr, From: from, Username: username, Password: password}, nil
+}
+
+// smtpTimeout bağlamında süre sınırı olmayan gönderimlerin en uzun süresi
+const smtpTimeout = 30 * time.Second
+
+// Send e-postayı gönderir. Bağlantı ctx'in süre sınırına (yoksa smtpTimeout)
+// uyar ve ctx iptal edildiğinde kapatılır; yanıt vermeyen bir sunucu
+// gönderimi süresiz bekletmez.
+func (m *SMTPMailer) Send(ctx context.Context, to, subject, body string) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, smtpTimeout)
+		defer cancel()
+	}
+	deadline, _ := ctx.Deadline()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", m.Addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	host, _, _ := net.SplitHostPort(m.Addr)
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if m.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", m.Username, m.Password, host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(m.From); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write([]byte(formatMail(m.From, to, subject, body, time.Now()))); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// FileMailer e-postaları göndermek yerine bir dosyanın sonuna yazar. Geliştirme
+// ve testlerde gönderilen e-postaları incelemek için kullanılır.
+type FileMailer struct {
+	Path string
+	From string
+	mu   sync.Mutex
+}
+
+// NewFileMailer dosyanın dizinini oluşturur ve dosya göndericisini döner
+func NewFileMailer(path, from string) (*FileMailer, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	return &FileMailer{Path: path, From: from}, nil
+}
+
+func (m *FileMailer) Send(ctx context.Context, to, subject, body string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	file, err := os.OpenFile(m.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.WriteString(formatMail(m.From, to, subject, body, time.Now()) + "\r\n")
+	return err
+}
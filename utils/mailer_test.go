@@ -0,0 +1,113 @@
+package utils
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// serveSMTP tek bir bağlantıyı kabul eden ve en temel SMTP komutlarını
+// yanıtlayan bir test sunucusu başlatır; alınan mesaj gövdesi kanala yazılır
+func serveSMTP(t *testing.T) (string, <-chan string) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	messages := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+		reply("220 test")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			command := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+				reply("250 test")
+			case command == "DATA":
+				reply("354 devam")
+				var body strings.Builder
+				for {
+					line, err := reader.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if line == ".\r\n" {
+						break
+					}
+					body.WriteString(line)
+				}
+				messages <- body.String()
+				reply("250 tamam")
+			case command == "QUIT":
+				reply("221 güle güle")
+				return
+			default:
+				reply("250 tamam")
+			}
+		}
+	}()
+	return listener.Addr().String(), messages
+}
+
+func TestSMTPMailerSend(t *testing.T) {
+	addr, messages := serveSMTP(t)
+	mailer := &SMTPMailer{Addr: addr, From: "kitap@example.com"}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := mailer.Send(ctx, "okur@example.com", "Konu", "Merhaba"); err != nil {
+		t.Fatal(err)
+	}
+	message := <-messages
+	for _, want := range []string{"To: okur@example.com", "Merhaba"} {
+		if !strings.Contains(message, want) {
+			t.Errorf("mesaj %q içermiyor:\n%s", want, message)
+		}
+	}
+}
+
+func TestSMTPMailerSendHonoursContext(t *testing.T) {
+	// Bağlantıyı kabul edip hiç yanıt vermeyen sunucu
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	mailer := &SMTPMailer{Addr: listener.Addr().String(), From: "kitap@example.com"}
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := mailer.Send(ctx, "okur@example.com", "Konu", "Merhaba"); err == nil {
+		t.Fatal("yanıt vermeyen sunucuda hata bekleniyordu")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("Send %v sürdü, bağlamın süre sınırına uymadı", elapsed)
+	}
+}
@@ -0,0 +1,160 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrMetadataNotFound sağlayıcı ISBN için kayıt bulamadığında döner
+var ErrMetadataNotFound = errors.New("ISBN için kitap bilgisi bulunamadı")
+
+// BookMetadata dış kaynaktan alınan ve kitap formunu önceden doldurmak için
+// kullanılan bilgiler
+type BookMetadata struct {
+	ISBN10      string `json:"isbn10,omitempty"`
+	ISBN13      string `json:"isbn13"`
+	Title       string `json:"title"`
+	Author      string `json:"author"`
+	PageCount   int    `json:"page_count,omitempty"`
+	PublishYear int    `json:"publish_year,omitempty"`
+	Source      string `json:"source"`
+}
+
+// MetadataProvider ISBN ile kitap bilgisi arayan kaynak. Lookup normalize
+// edilmiş ISBN-13 alır, kayıt yoksa ErrMetadataNotFound döner.
+type MetadataProvider interface {
+	Name() string
+	Lookup(ctx context.Context, isbn13 string) (*BookMetadata, error)
+}
+
+// yearPattern yayın tarihi metnindeki dört basamaklı yılı bulur
+var yearPattern = regexp.MustCompile(`\b(\d{4})\b`)
+
+// OpenLibraryProvider Open Library Books API'sini kullanan sağlayıcı
+type OpenLibraryProvider struct {
+	BaseURL string
+	Client  *http.Client
+}
+
+// NewOpenLibraryProvider verilen adresle (boşsa openlibrary.org) sağlayıcı oluşturur
+func NewOpenLibraryProvider(baseURL string) *OpenLibraryProvider {
+	if baseURL == "" {
+		baseURL = "https://openlibrary.org"
+	}
+	return &OpenLibraryProvider{
+		BaseURL: strings.TrimRight(baseURL, "/"),
+		Client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *OpenLibraryProvider) Name() string { return "openlibrary" }
+
+func (p *OpenLibraryProvider) Lookup(ctx context.Context, isbn13 string) (*BookMetadata, error) {
+	bibkey := "ISBN:" + isbn13
+	query := url.Values{"bibkeys": {bibkey}, "format": {"json"}, "jscmd": {"data"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.BaseURL+"/api/books?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("open library %d durum kodu döndü", resp.StatusCode)
+	}
+
+	var books map[string]struct {
+		Title   string `json:"title"`
+		Authors []struct {
+			Name string `json:"name"`
+		} `json:"authors"`
+		NumberOfPages int    `json:"number_of_pages"`
+		PublishDate   string `json:"publish_date"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&books); err != nil {
+		return nil, err
+	}
+
+	book, ok := books[bibkey]
+	if !ok {
+		return nil, ErrMetadataNotFound
+	}
+
+	authors := make([]string, 0, len(book.Authors))
+	for _, author := range book.Authors {
+		authors = append(authors, author.Name)
+	}
+	metadata := &BookMetadata{
+		ISBN10:    ISBN13To10(isbn13),
+		ISBN13:    isbn13,
+		Title:     book.Title,
+		Author:    strings.Join(authors, " & "),
+		PageCount: book.NumberOfPages,
+		Source:    p.Name(),
+	}
+	if match := yearPattern.FindString(book.PublishDate); match != "" {
+		metadata.PublishYear, _ = strconv.Atoi(match)
+	}
+	return metadata, nil
+}
+
+// FixtureProvider ISBN-13 anahtarlı yerel bir JSON dosyasından okuyan sağlayıcı.
+// Ağ erişimi olmayan ortamlarda ve testlerde kullanılır.
+type FixtureProvider struct {
+	books map[string]BookMetadata
+}
+
+// NewFixtureProvider JSON dosyasını yükler. Anahtarlar tire içerebilir ve
+// ISBN-10 olabilir; yüklenirken ISBN-13'e çevrilir.
+func NewFixtureProvider(path string) (*FixtureProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]BookMetadata
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("%s okunamadı: %w", path, err)
+	}
+
+	books := make(map[string]BookMetadata, len(raw))
+	for key, metadata := range raw {
+		isbn := NormalizeISBN(key)
+		switch {
+		case ValidISBN13(isbn):
+		case ValidISBN10(isbn):
+			isbn = ISBN10To13(isbn)
+		default:
+			return nil, fmt.Errorf("%s içinde geçersiz ISBN: %q", path, key)
+		}
+		metadata.ISBN13 = isbn
+		if metadata.ISBN10 == "" {
+			metadata.ISBN10 = ISBN13To10(isbn)
+		}
+		books[isbn] = metadata
+	}
+	return &FixtureProvider{books: books}, nil
+}
+
+func (p *FixtureProvider) Name() string { return "fixture" }
+
+func (p *FixtureProvider) Lookup(ctx context.Context, isbn13 string) (*BookMetadata, error) {
+	metadata, ok := p.books[isbn13]
+	if !ok {
+		return nil, ErrMetadataNotFound
+	}
+	metadata.Source = p.Name()
+	return &metadata, nil
+}
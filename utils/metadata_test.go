@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeFixture(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "fixtures.json")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestFixtureProviderLookup(t *testing.T) {
+	path := writeFixture(t, `{
+		"978-0-441-17271-9": {"title": "Dune", "author": "Frank Herbert", "page_count": 412, "publish_year": 1990},
+		"0-8044-2957-x": {"title": "Test X", "author": "Yazar"},
+		"979-10-323-0569-0": {"title": "Test 979", "author": "Yazar"}
+	}`)
+	provider, err := NewFixtureProvider(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		isbn13 string
+		title  string
+		isbn10 string
+	}{
+		{"9780441172719", "Dune", "0441172717"},
+		{"9780804429573", "Test X", "080442957X"}, // ISBN-10 anahtar ISBN-13'e çevrilir
+		{"9791032305690", "Test 979", ""},
+	}
+	for _, tt := range tests {
+		metadata, err := provider.Lookup(context.Background(), tt.isbn13)
+		if err != nil {
+			t.Errorf("Lookup(%q) error: %v", tt.isbn13, err)
+			continue
+		}
+		if metadata.Title != tt.title || metadata.ISBN13 != tt.isbn13 || metadata.ISBN10 != tt.isbn10 {
+			t.Errorf("Lookup(%q) = %+v, want title %q isbn10 %q", tt.isbn13, metadata, tt.title, tt.isbn10)
+		}
+		if metadata.Source != "fixture" {
+			t.Errorf("Lookup(%q).Source = %q, want fixture", tt.isbn13, metadata.Source)
+		}
+	}
+
+	if _, err := provider.Lookup(context.Background(), "9780140449136"); !errors.Is(err, ErrMetadataNotFound) {
+		t.Errorf("Lookup(unknown) error = %v, want ErrMetadataNotFound", err)
+	}
+}
+
+func TestNewFixtureProviderErrors(t *testing.T) {
+	tests := map[string]string{
+		"invalid isbn": `{"123": {"title": "x"}}`,
+		"invalid json": `{`,
+	}
+	for name, content := range tests {
+		if _, err := NewFixtureProvider(writeFixture(t, content)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+	if _, err := NewFixtureProvider(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("missing file: expected error")
+	}
+}
+
+func TestBundledFixtures(t *testing.T) {
+	provider, err := NewFixtureProvider("../fixtures/book_metadata.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	metadata, err := provider.Lookup(context.Background(), "9780441013593")
+	if err != nil || metadata.Title != "Dune" {
+		t.Errorf("Lookup(Dune) = %+v, %v", metadata, err)
+	}
+}
@@ -0,0 +1,328 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	MergePatchContentType = "application/merge-patch+json"
+	JSONPatchContentType  = "application/json-patch+json"
+)
+
+// JSONPatchOperation RFC 6902 JSON Patch işlemi
+type JSONPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// ApplyMergePatch RFC 7396 JSON Merge Patch uygular. null değerler alanı siler,
+// nesneler özyinelemeli olarak birleştirilir, diğer değerler olduğu gibi yazılır.
+func ApplyMergePatch(doc map[string]interface{}, patch []byte) (map[string]interface{}, error) {
+	var patchValue interface{}
+	if err := json.Unmarshal(patch, &patchValue); err != nil {
+		return nil, err
+	}
+
+	patchObject, ok := patchValue.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("merge patch bir JSON nesnesi olmalıdır")
+	}
+	return mergeObjects(cloneObject(doc), patchObject), nil
+}
+
+func mergeObjects(target, patch map[string]interface{}) map[string]interface{} {
+	for key, value := range patch {
+		if value == nil {
+			delete(target, key)
+			continue
+		}
+
+		patchChild, ok := value.(map[string]interface{})
+		if !ok {
+			target[key] = value
+			continue
+		}
+		targetChild, ok := target[key].(map[string]interface{})
+		if !ok {
+			targetChild = map[string]interface{}{}
+		}
+		target[key] = mergeObjects(targetChild, patchChild)
+	}
+	return target
+}
+
+// ApplyJSONPatch RFC 6902 JSON Patch işlemlerini sırayla uygular. Bir işlem
+// başarısız olursa belge değiştirilmeden hata döner.
+func ApplyJSONPatch(doc map[string]interface{}, patch []byte) (map[string]interface{}, error) {
+	var operations []JSONPatchOperation
+	if err := json.Unmarshal(patch, &operations); err != nil {
+		return nil, err
+	}
+
+	var result interface{} = cloneObject(doc)
+	for i, operation := range operations {
+		var err error
+		result, err = applyOperation(result, operation)
+		if err != nil {
+			return nil, fmt.Errorf("işlem %d (%s %s): %w", i, operation.Op, operation.Path, err)
+		}
+	}
+
+	object, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("patch sonucu bir JSON nesnesi olmalıdır")
+	}
+	return object, nil
+}
+
+func applyOperation(doc interface{}, operation JSONPatchOperation) (interface{}, error) {
+	switch operation.Op {
+	case "add", "replace", "test":
+		if operation.Value == nil {
+			return nil, errors.New("value alanı zorunludur")
+		}
+		var value interface{}
+		if err := json.Unmarshal(operation.Value, &value); err != nil {
+			return nil, err
+		}
+		if operation.Op == "test" {
+			current, err := getPointer(doc, operation.Path)
+			if err != nil {
+				return nil, err
+			}
+			if !reflect.DeepEqual(current, value) {
+				return nil, errors.New("test başarısız")
+			}
+			return doc, nil
+		}
+		if operation.Op == "replace" {
+			if _, err := getPointer(doc, operation.Path); err != nil {
+				return nil, err
+			}
+			doc, _, err := removePointer(doc, operation.Path)
+			if err != nil {
+				return nil, err
+			}
+			return addPointer(doc, operation.Path, value)
+		}
+		return addPointer(doc, operation.Path, value)
+	case "remove":
+		doc, _, err := removePointer(doc, operation.Path)
+		return doc, err
+	case "move":
+		if strings.HasPrefix(operation.Path, operation.From+"/") {
+			return nil, errors.New("bir değer kendi alt yoluna taşınamaz")
+		}
+		doc, value, err := removePointer(doc, operation.From)
+		if err != nil {
+			return nil, err
+		}
+		return addPointer(doc, operation.Path, value)
+	case "copy":
+		value, err := getPointer(doc, operation.From)
+		if err != nil {
+			return nil, err
+		}
+		return addPointer(doc, operation.Path, deepCopy(value))
+	default:
+		return nil, fmt.Errorf("desteklenmeyen işlem: %q", operation.Op)
+	}
+}
+
+// parsePointer RFC 6901 JSON Pointer'ı parçalara ayırır
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("geçersiz JSON pointer: %q", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		token = strings.ReplaceAll(token, "~1", "/")
+		tokens[i] = strings.ReplaceAll(token, "~0", "~")
+	}
+	return tokens, nil
+}
+
+func getPointer(doc interface{}, pointer string) (interface{}, error) {
+	tokens, err := parsePointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+
+	current := doc
+	for _, token := range tokens {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			value, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("yol bulunamadı: %q", pointer)
+			}
+			current = value
+		case []interface{}:
+			index, err := arrayIndex(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			current = node[index]
+		default:
+			return nil, fmt.Errorf("yol bulunamadı: %q", pointer)
+		}
+	}
+	return current, nil
+}
+
+// addPointer değeri verilen yola ekler ve güncellenmiş belgeyi döner
+func addPointer(doc interface{}, pointer string, value interface{}) (interface{}, error) {
+	tokens, err := parsePointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return value, nil
+	}
+	return addTokens(doc, tokens, value)
+}
+
+func addTokens(node interface{}, tokens []string, value interface{}) (interface{}, error) {
+	token := tokens[0]
+	last := len(tokens) == 1
+
+	switch container := node.(type) {
+	case map[string]interface{}:
+		if last {
+			container[token] = value
+			return container, nil
+		}
+		child, ok := container[token]
+		if !ok {
+			return nil, fmt.Errorf("yol bulunamadı: %q", token)
+		}
+		updated, err := addTokens(child, tokens[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		container[token] = updated
+		return container, nil
+	case []interface{}:
+		if last {
+			if token == "-" {
+				return append(container, value), nil
+			}
+			index, err := arrayIndex(token, len(container))
+			if err != nil {
+				return nil, err
+			}
+			container = append(container, nil)
+			copy(container[index+1:], container[index:])
+			container[index] = value
+			return container, nil
+		}
+		index, err := arrayIndex(token, len(container)-1)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := addTokens(container[index], tokens[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		container[index] = updated
+		return container, nil
+	default:
+		return nil, fmt.Errorf("yol bulunamadı: %q", token)
+	}
+}
+
+// removePointer verilen yoldaki değeri siler; güncellenmiş belgeyi ve silinen
+// değeri döner
+func removePointer(doc interface{}, pointer string) (interface{}, interface{}, error) {
+	tokens, err := parsePointer(pointer)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, doc, nil
+	}
+	return removeTokens(doc, tokens)
+}
+
+func removeTokens(node interface{}, tokens []string) (interface{}, interface{}, error) {
+	token := tokens[0]
+	last := len(tokens) == 1
+
+	switch container := node.(type) {
+	case map[string]interface{}:
+		child, ok := container[token]
+		if !ok {
+			return nil, nil, fmt.Errorf("yol bulunamadı: %q", token)
+		}
+		if last {
+			delete(container, token)
+			return container, child, nil
+		}
+		updated, removed, err := removeTokens(child, tokens[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+		container[token] = updated
+		return container, removed, nil
+	case []interface{}:
+		index, err := arrayIndex(token, len(container)-1)
+		if err != nil {
+			return nil, nil, err
+		}
+		if last {
+			removed := container[index]
+			return append(container[:index], container[index+1:]...), removed, nil
+		}
+		updated, removed, err := removeTokens(container[index], tokens[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+		container[index] = updated
+		return container, removed, nil
+	default:
+		return nil, nil, fmt.Errorf("yol bulunamadı: %q", token)
+	}
+}
+
+// arrayIndex RFC 6901 dizi indeksini ayrıştırır: yalnızca rakamlardan oluşur,
+// başında sıfır olamaz ve max'ı aşamaz
+func arrayIndex(token string, max int) (int, error) {
+	index, err := strconv.Atoi(token)
+	if err != nil || token[0] < '0' || token[0] > '9' || index > max || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("geçersiz dizi indeksi: %q", token)
+	}
+	return index, nil
+}
+
+func cloneObject(doc map[string]interface{}) map[string]interface{} {
+	return deepCopy(doc).(map[string]interface{})
+}
+
+func deepCopy(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			copied[key] = deepCopy(child)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, child := range v {
+			copied[i] = deepCopy(child)
+		}
+		return copied
+	default:
+		return v
+	}
+}
@@ -0,0 +1,151 @@
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// decodeObject testlerdeki JSON belgeyi çözer
+func decodeObject(t *testing.T, value string) map[string]interface{} {
+	t.Helper()
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &doc); err != nil {
+		t.Fatalf("geçersiz test belgesi %q: %v", value, err)
+	}
+	return doc
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		patch string
+		want  string
+		err   bool
+	}{
+		{"alan değiştirilir", `{"title":"a","rating":3}`, `{"rating":5}`, `{"title":"a","rating":5}`, false},
+		{"null alanı siler", `{"title":"a","notes":"n"}`, `{"notes":null}`, `{"title":"a"}`, false},
+		{"olmayan alanı silmek hata değildir", `{"title":"a"}`, `{"notes":null}`, `{"title":"a"}`, false},
+		{"nesneler özyinelemeli birleşir", `{"a":{"b":1,"c":2}}`, `{"a":{"c":null,"d":3}}`, `{"a":{"b":1,"d":3}}`, false},
+		{"nesne olmayan değer nesneyle değişir", `{"a":1}`, `{"a":{"b":2}}`, `{"a":{"b":2}}`, false},
+		{"diziler bütünüyle değişir", `{"tags":["x","y"]}`, `{"tags":["z"]}`, `{"tags":["z"]}`, false},
+		{"boş patch değiştirmez", `{"a":1}`, `{}`, `{"a":1}`, false},
+		{"patch nesne olmalıdır", `{"a":1}`, `["a"]`, "", true},
+		{"geçersiz JSON", `{"a":1}`, `{`, "", true},
+	}
+	for _, tt := range tests {
+		doc := decodeObject(t, tt.doc)
+		got, err := ApplyMergePatch(doc, []byte(tt.patch))
+		if tt.err {
+			if err == nil {
+				t.Errorf("%s: hata bekleniyordu, sonuç %v", tt.name, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if want := decodeObject(t, tt.want); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, want)
+		}
+		if original := decodeObject(t, tt.doc); !reflect.DeepEqual(doc, original) {
+			t.Errorf("%s: özgün belge değişti: %v", tt.name, doc)
+		}
+	}
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		patch string
+		want  string
+	}{
+		{"add alan ekler", `{"a":1}`, `[{"op":"add","path":"/b","value":2}]`, `{"a":1,"b":2}`},
+		{"add mevcut alanı değiştirir", `{"a":1}`, `[{"op":"add","path":"/a","value":[1]}]`, `{"a":[1]}`},
+		{"add dizinin sonuna - ile ekler", `{"tags":["x"]}`, `[{"op":"add","path":"/tags/-","value":"y"}]`, `{"tags":["x","y"]}`},
+		{"add dizide araya ekler", `{"tags":["x","z"]}`, `[{"op":"add","path":"/tags/1","value":"y"}]`, `{"tags":["x","y","z"]}`},
+		{"add dizinin uzunluğundaki indekse ekler", `{"tags":["x"]}`, `[{"op":"add","path":"/tags/1","value":"y"}]`, `{"tags":["x","y"]}`},
+		{"add iç içe nesneye ekler", `{"a":{"b":1}}`, `[{"op":"add","path":"/a/c","value":2}]`, `{"a":{"b":1,"c":2}}`},
+		{"remove alanı siler", `{"a":1,"b":2}`, `[{"op":"remove","path":"/b"}]`, `{"a":1}`},
+		{"remove dizi elemanını siler", `{"tags":["x","y","z"]}`, `[{"op":"remove","path":"/tags/1"}]`, `{"tags":["x","z"]}`},
+		{"replace değeri değiştirir", `{"a":1}`, `[{"op":"replace","path":"/a","value":"b"}]`, `{"a":"b"}`},
+		{"replace dizi elemanını değiştirir", `{"tags":["x","y"]}`, `[{"op":"replace","path":"/tags/0","value":"z"}]`, `{"tags":["z","y"]}`},
+		{"move değeri taşır", `{"a":1,"b":{}}`, `[{"op":"move","from":"/a","path":"/b/c"}]`, `{"b":{"c":1}}`},
+		{"move dizide taşır", `{"tags":["x","y","z"]}`, `[{"op":"move","from":"/tags/0","path":"/tags/-"}]`, `{"tags":["y","z","x"]}`},
+		{"copy derin kopyalar", `{"a":{"b":1}}`, `[{"op":"copy","from":"/a","path":"/c"},{"op":"replace","path":"/c/b","value":2}]`, `{"a":{"b":1},"c":{"b":2}}`},
+		{"test eşleşirse geçer", `{"a":{"b":[1,"x"]}}`, `[{"op":"test","path":"/a/b","value":[1,"x"]}]`, `{"a":{"b":[1,"x"]}}`},
+		{"~1 eğik çizgidir", `{"a/b":1}`, `[{"op":"replace","path":"/a~1b","value":2}]`, `{"a/b":2}`},
+		{"~0 tildedir", `{"a~b":1}`, `[{"op":"remove","path":"/a~0b"}]`, `{}`},
+		{"~01 önce ~1 çözülür", `{"~1":1}`, `[{"op":"remove","path":"/~01"}]`, `{}`},
+		{"boş anahtar", `{"":1}`, `[{"op":"replace","path":"/","value":2}]`, `{"":2}`},
+		{"işlemler sırayla uygulanır", `{"a":1}`, `[{"op":"add","path":"/b","value":2},{"op":"move","from":"/b","path":"/c"},{"op":"test","path":"/c","value":2}]`, `{"a":1,"c":2}`},
+		{"kök değiştirilir", `{"a":1}`, `[{"op":"replace","path":"","value":{"b":2}}]`, `{"b":2}`},
+	}
+	for _, tt := range tests {
+		doc := decodeObject(t, tt.doc)
+		got, err := ApplyJSONPatch(doc, []byte(tt.patch))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if want := decodeObject(t, tt.want); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, want)
+		}
+		if original := decodeObject(t, tt.doc); !reflect.DeepEqual(doc, original) {
+			t.Errorf("%s: özgün belge değişti: %v", tt.name, doc)
+		}
+	}
+}
+
+func TestApplyJSONPatchErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		patch string
+	}{
+		{"geçersiz JSON", `{"a":1}`, `[{`},
+		{"desteklenmeyen işlem", `{"a":1}`, `[{"op":"merge","path":"/a","value":1}]`},
+		{"pointer / ile başlamalı", `{"a":1}`, `[{"op":"add","path":"a","value":1}]`},
+		{"add için value zorunlu", `{"a":1}`, `[{"op":"add","path":"/b"}]`},
+		{"add üst yolu olmayan alana", `{"a":1}`, `[{"op":"add","path":"/x/y","value":1}]`},
+		{"remove olmayan alan", `{"a":1}`, `[{"op":"remove","path":"/b"}]`},
+		{"replace olmayan alan", `{"a":1}`, `[{"op":"replace","path":"/b","value":1}]`},
+		{"test başarısız", `{"a":1}`, `[{"op":"test","path":"/a","value":2}]`},
+		{"test türü farklı", `{"a":1}`, `[{"op":"test","path":"/a","value":"1"}]`},
+		{"test olmayan alan", `{"a":1}`, `[{"op":"test","path":"/b","value":1}]`},
+		{"dizi indeksi aralık dışında", `{"tags":["x"]}`, `[{"op":"replace","path":"/tags/1","value":"y"}]`},
+		{"add indeksi uzunluğu aşar", `{"tags":["x"]}`, `[{"op":"add","path":"/tags/2","value":"y"}]`},
+		{"negatif indeks", `{"tags":["x"]}`, `[{"op":"remove","path":"/tags/-1"}]`},
+		{"başında sıfır olan indeks", `{"tags":["x","y"]}`, `[{"op":"remove","path":"/tags/01"}]`},
+		{"işaretli indeks", `{"tags":["x","y"]}`, `[{"op":"remove","path":"/tags/+1"}]`},
+		{"sayı olmayan indeks", `{"tags":["x"]}`, `[{"op":"remove","path":"/tags/a"}]`},
+		{"- yalnızca eklemede", `{"tags":["x"]}`, `[{"op":"remove","path":"/tags/-"}]`},
+		{"skaler değerin altı", `{"a":1}`, `[{"op":"add","path":"/a/b","value":1}]`},
+		{"alt yola taşıma", `{"a":{"b":1}}`, `[{"op":"move","from":"/a","path":"/a/b/c"}]`},
+		{"olmayan yerden kopyalama", `{"a":1}`, `[{"op":"copy","from":"/b","path":"/c"}]`},
+		{"sonuç nesne olmalı", `{"a":1}`, `[{"op":"replace","path":"","value":[1]}]`},
+	}
+	for _, tt := range tests {
+		doc := decodeObject(t, tt.doc)
+		if got, err := ApplyJSONPatch(doc, []byte(tt.patch)); err == nil {
+			t.Errorf("%s: hata bekleniyordu, sonuç %v", tt.name, got)
+		}
+		if original := decodeObject(t, tt.doc); !reflect.DeepEqual(doc, original) {
+			t.Errorf("%s: özgün belge değişti: %v", tt.name, doc)
+		}
+	}
+}
+
+func TestApplyJSONPatchIsAtomic(t *testing.T) {
+	doc := decodeObject(t, `{"a":1,"tags":["x"]}`)
+	patch := `[{"op":"add","path":"/b","value":2},{"op":"remove","path":"/tags/0"},{"op":"test","path":"/a","value":9}]`
+	if _, err := ApplyJSONPatch(doc, []byte(patch)); err == nil {
+		t.Fatal("hata bekleniyordu")
+	}
+	if want := decodeObject(t, `{"a":1,"tags":["x"]}`); !reflect.DeepEqual(doc, want) {
+		t.Fatalf("başarısız patch belgeyi değiştirdi: %v", doc)
+	}
+}
@@ -0,0 +1,206 @@
+package utils
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// Öneri puanındaki bileşenlerin ağırlıkları
+const (
+	recommendAuthorWeight  = 1.0
+	recommendTagWeight     = 0.5
+	recommendSimilarWeight = 1.0
+	recommendAverageWeight = 0.25
+	// recommendSimilarityShrink az sayıda ortak kitaba dayanan benzerlikleri zayıflatır
+	recommendSimilarityShrink = 2.0
+	// recommendNeutralRating 1-5 ölçeğinin ortası; üstü beğeni, altı beğenmeme sayılır
+	recommendNeutralRating = 3.0
+)
+
+// RatedBook öneri hesaplamasında kullanılan puanlanmış bir kitap. Key aynı
+// kitabı farklı kullanıcılarda eşleştiren karşılaştırma anahtarıdır.
+type RatedBook struct {
+	UserID uint
+	Key    string
+	Author string
+	Tags   []string
+	Rating int
+}
+
+// RecommendationCandidate önerilebilecek bir kitap. Boost kaynağa bağlı ek
+// puandır (ör. istek listesindeki öncelik).
+type RecommendationCandidate struct {
+	Key    string
+	Author string
+	Tags   []string
+	Boost  float64
+}
+
+// Recommendation puanlanmış öneri ve puanın gerekçeleri
+type Recommendation struct {
+	Key     string
+	Score   float64
+	Reasons []string
+}
+
+// ratingWeight puanı beğeni ağırlığına çevirir: 5 → +2, 3 → 0, 1 → -2
+func ratingWeight(rating int) float64 {
+	return float64(rating) - recommendNeutralRating
+}
+
+// normalizeTag etiketleri büyük/küçük harf ve boşluk farkı olmadan karşılaştırır
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// affinities kullanıcının kitaplarından yazar ve etiket başına ortalama beğeni ağırlığını hesaplar
+func affinities(own []RatedBook) (map[string]float64, map[string]float64) {
+	authorSum, authorCount := map[string]float64{}, map[string]float64{}
+	tagSum, tagCount := map[string]float64{}, map[string]float64{}
+	for _, book := range own {
+		weight := ratingWeight(book.Rating)
+		for _, name := range SplitAuthorNames(book.Author) {
+			key := AuthorNameKey(name)
+			authorSum[key] += weight
+			authorCount[key]++
+		}
+		for _, tag := range book.Tags {
+			tag = normalizeTag(tag)
+			tagSum[tag] += weight
+			tagCount[tag]++
+		}
+	}
+	for key := range authorSum {
+		authorSum[key] /= authorCount[key]
+	}
+	for tag := range tagSum {
+		tagSum[tag] /= tagCount[tag]
+	}
+	return authorSum, tagSum
+}
+
+// similarities kullanıcının diğer kullanıcılarla puan benzerliğini hesaplar.
+// Ortak kitaplardaki puan farkı 0 ise benzerlik 1, 4 ise -1'dir; az ortak
+// kitaba dayanan benzerlikler sıfıra çekilir.
+func similarities(own []RatedBook, others []RatedBook) map[uint]float64 {
+	ownRatings := map[string]int{}
+	for _, book := range own {
+		ownRatings[book.Key] = book.Rating
+	}
+
+	agreement, common := map[uint]float64{}, map[uint]float64{}
+	for _, book := range others {
+		rating, ok := ownRatings[book.Key]
+		if !ok {
+			continue
+		}
+		agreement[book.UserID] += 1 - math.Abs(float64(rating-book.Rating))/2
+		common[book.UserID]++
+	}
+
+	result := make(map[uint]float64, len(agreement))
+	for userID, sum := range agreement {
+		result[userID] = sum / (common[userID] + recommendSimilarityShrink)
+	}
+	return result
+}
+
+// RecommendBooks adayları kullanıcının kendi puanlarına göre sıralar ve en
+// yüksek puanlı limit kadar öneriyi döner. Puan; kullanıcının sevdiği
+// yazarlar ve etiketlerle örtüşmeden, puanlama alışkanlığı benzeyen
+// kullanıcıların adaya verdiği puanlardan (basit işbirlikçi filtreleme),
+// adayın diğer kullanıcılardaki ortalama puanından ve Boost'tan oluşur;
+// puanı negatif olan adaylar önerilmez. Sonuç yalnızca girdilere bağlıdır;
+// eşit puanlılar Key'e göre sıralanır.
+func RecommendBooks(own []RatedBook, others []RatedBook, candidates []RecommendationCandidate, limit int) []Recommendation {
+	authorAffinity, tagAffinity := affinities(own)
+	similarity := similarities(own, others)
+
+	ratingsByKey := map[string][]RatedBook{}
+	for _, book := range others {
+		ratingsByKey[book.Key] = append(ratingsByKey[book.Key], book)
+	}
+
+	recommendations := make([]Recommendation, 0, len(candidates))
+	for _, candidate := range candidates {
+		recommendation := Recommendation{Key: candidate.Key, Score: candidate.Boost}
+
+		// Birden fazla yazarlı kitaplarda en çok sevilen yazar sayılır
+		authorScore, authorMatched, favoriteAuthors := 0.0, false, []string{}
+		for _, name := range SplitAuthorNames(candidate.Author) {
+			if affinity, ok := authorAffinity[AuthorNameKey(name)]; ok {
+				if !authorMatched || affinity > authorScore {
+					authorScore = affinity
+				}
+				authorMatched = true
+				if affinity > 0 {
+					favoriteAuthors = append(favoriteAuthors, name)
+				}
+			}
+		}
+		recommendation.Score += recommendAuthorWeight * authorScore
+		if len(favoriteAuthors) > 0 {
+			recommendation.Reasons = append(recommendation.Reasons, "Sevdiğiniz yazar: "+strings.Join(favoriteAuthors, ", "))
+		}
+
+		tagScore, favoriteTags, seen := 0.0, []string{}, map[string]bool{}
+		for _, tag := range candidate.Tags {
+			tag = normalizeTag(tag)
+			if seen[tag] {
+				continue
+			}
+			seen[tag] = true
+			if affinity, ok := tagAffinity[tag]; ok {
+				tagScore += affinity
+				if affinity > 0 {
+					favoriteTags = append(favoriteTags, tag)
+				}
+			}
+		}
+		if len(seen) > 0 {
+			recommendation.Score += recommendTagWeight * tagScore / float64(len(seen))
+		}
+		if len(favoriteTags) > 0 {
+			sort.Strings(favoriteTags)
+			recommendation.Reasons = append(recommendation.Reasons, "Sevdiğiniz etiketler: "+strings.Join(favoriteTags, ", "))
+		}
+
+		similarSum, similarWeight, ratingSum := 0.0, 0.0, 0.0
+		ratings := ratingsByKey[candidate.Key]
+		for _, book := range ratings {
+			ratingSum += float64(book.Rating)
+			if sim, ok := similarity[book.UserID]; ok {
+				similarSum += sim * ratingWeight(book.Rating)
+				similarWeight += math.Abs(sim)
+			}
+		}
+		if similarWeight > 0 {
+			similarScore := similarSum / similarWeight
+			recommendation.Score += recommendSimilarWeight * similarScore
+			if similarScore > 0 {
+				recommendation.Reasons = append(recommendation.Reasons, "Benzer zevkteki okuyucular beğendi")
+			}
+		}
+		if len(ratings) > 0 {
+			recommendation.Score += recommendAverageWeight * (ratingSum/float64(len(ratings)) - recommendNeutralRating)
+		}
+
+		recommendation.Score = math.Round(recommendation.Score*1000) / 1000
+		if recommendation.Score < 0 {
+			continue
+		}
+		recommendations = append(recommendations, recommendation)
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		if recommendations[i].Score != recommendations[j].Score {
+			return recommendations[i].Score > recommendations[j].Score
+		}
+		return recommendations[i].Key < recommendations[j].Key
+	})
+	if limit > 0 && len(recommendations) > limit {
+		recommendations = recommendations[:limit]
+	}
+	return recommendations
+}
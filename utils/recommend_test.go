@@ -0,0 +1,168 @@
+package utils
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestAffinities(t *testing.T) {
+	own := []RatedBook{
+		{Key: "dune", Author: "Frank Herbert", Tags: []string{"Bilim Kurgu"}, Rating: 5},
+		{Key: "messiah", Author: "Herbert, Frank", Tags: []string{" bilim kurgu "}, Rating: 3},
+		{Key: "hobbit", Author: "J.R.R. Tolkien", Tags: []string{"fantastik"}, Rating: 1},
+	}
+	authors, tags := affinities(own)
+
+	tests := []struct {
+		name   string
+		values map[string]float64
+		key    string
+		want   float64
+	}{
+		{"aynı yazarın puanlarının ortalaması", authors, AuthorNameKey("Frank Herbert"), 1},
+		{"sevilmeyen yazar", authors, AuthorNameKey("J. R. R. Tolkien"), -2},
+		{"etiketler büyük/küçük harf ve boşluk farkı olmadan", tags, "bilim kurgu", 1},
+		{"sevilmeyen etiket", tags, "fantastik", -2},
+	}
+	for _, tt := range tests {
+		if got, ok := tt.values[tt.key]; !ok || got != tt.want {
+			t.Errorf("%s: %q = %v (var: %v), want %v", tt.name, tt.key, got, ok, tt.want)
+		}
+	}
+	if len(authors) != 2 || len(tags) != 2 {
+		t.Errorf("authors = %v, tags = %v", authors, tags)
+	}
+}
+
+func TestSimilaritiesShrink(t *testing.T) {
+	own := []RatedBook{
+		{Key: "a", Rating: 5},
+		{Key: "b", Rating: 4},
+		{Key: "c", Rating: 1},
+	}
+	others := []RatedBook{
+		// 2: tek ortak kitapta tam uyum
+		{UserID: 2, Key: "a", Rating: 5},
+		// 3: üç ortak kitapta tam uyum
+		{UserID: 3, Key: "a", Rating: 5},
+		{UserID: 3, Key: "b", Rating: 4},
+		{UserID: 3, Key: "c", Rating: 1},
+		// 4: tek ortak kitapta tam zıt puan
+		{UserID: 4, Key: "a", Rating: 1},
+		// 5: ortak kitap yok
+		{UserID: 5, Key: "z", Rating: 5},
+	}
+	got := similarities(own, others)
+
+	tests := []struct {
+		userID uint
+		want   float64
+	}{
+		{2, 1.0 / 3},
+		{3, 3.0 / 5},
+		{4, -1.0 / 3},
+	}
+	for _, tt := range tests {
+		if math.Abs(got[tt.userID]-tt.want) > 1e-9 {
+			t.Errorf("similarity[%d] = %v, want %v", tt.userID, got[tt.userID], tt.want)
+		}
+	}
+	if _, ok := got[5]; ok {
+		t.Error("ortak kitabı olmayan kullanıcı için benzerlik hesaplandı")
+	}
+	if got[2] >= got[3] {
+		t.Errorf("az ortak kitaba dayanan benzerlik zayıflatılmadı: %v >= %v", got[2], got[3])
+	}
+}
+
+func TestRecommendBooks(t *testing.T) {
+	own := []RatedBook{
+		{Key: "dune", Author: "Frank Herbert", Tags: []string{"bilim kurgu"}, Rating: 5},
+		{Key: "emma", Author: "Jane Austen", Tags: []string{"klasik"}, Rating: 1},
+	}
+
+	tests := []struct {
+		name       string
+		others     []RatedBook
+		candidates []RecommendationCandidate
+		limit      int
+		want       []string
+	}{
+		{
+			name: "sevilen yazar ve etiket öne çıkar, sevilmeyen önerilmez",
+			candidates: []RecommendationCandidate{
+				{Key: "persuasion", Author: "Jane Austen", Tags: []string{"klasik"}},
+				{Key: "neutral", Author: "Unknown"},
+				{Key: "messiah", Author: "Frank Herbert", Tags: []string{"Bilim Kurgu"}},
+			},
+			want: []string{"messiah", "neutral"},
+		},
+		{
+			name: "eşit puanlılar anahtara göre sıralanır",
+			candidates: []RecommendationCandidate{
+				{Key: "c", Author: "C"},
+				{Key: "a", Author: "A"},
+				{Key: "b", Author: "B"},
+			},
+			want: []string{"a", "b", "c"},
+		},
+		{
+			name: "limit uygulanır",
+			candidates: []RecommendationCandidate{
+				{Key: "c", Author: "C"},
+				{Key: "a", Author: "A"},
+				{Key: "b", Author: "B"},
+			},
+			limit: 2,
+			want:  []string{"a", "b"},
+		},
+		{
+			name: "boost eşitliği bozar",
+			candidates: []RecommendationCandidate{
+				{Key: "a", Author: "A"},
+				{Key: "b", Author: "B", Boost: 0.5},
+			},
+			want: []string{"b", "a"},
+		},
+		{
+			name: "benzer okuyucunun beğendiği önerilir, zıt zevktekinin beğendiği önerilmez",
+			others: []RatedBook{
+				{UserID: 2, Key: "dune", Rating: 5},
+				{UserID: 2, Key: "b", Rating: 5},
+				{UserID: 3, Key: "dune", Rating: 1},
+				{UserID: 3, Key: "a", Rating: 5},
+			},
+			candidates: []RecommendationCandidate{
+				{Key: "a", Author: "A"},
+				{Key: "b", Author: "B"},
+			},
+			want: []string{"b"},
+		},
+	}
+	for _, tt := range tests {
+		recommendations := RecommendBooks(own, tt.others, tt.candidates, tt.limit)
+		got := make([]string, 0, len(recommendations))
+		for _, recommendation := range recommendations {
+			got = append(got, recommendation.Key)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRecommendBooksReasons(t *testing.T) {
+	own := []RatedBook{{Key: "dune", Author: "Frank Herbert", Tags: []string{"bilim kurgu"}, Rating: 5}}
+	candidates := []RecommendationCandidate{{Key: "messiah", Author: "Frank Herbert", Tags: []string{"Bilim Kurgu"}}}
+
+	got := RecommendBooks(own, nil, candidates, 0)
+	want := []string{"Sevdiğiniz yazar: Frank Herbert", "Sevdiğiniz etiketler: bilim kurgu"}
+	if len(got) != 1 || !reflect.DeepEqual(got[0].Reasons, want) {
+		t.Fatalf("got %+v, want reasons %v", got, want)
+	}
+	// Yazar +2, etiket 0.5 * 2
+	if got[0].Score != 3 {
+		t.Errorf("score = %v, want 3", got[0].Score)
+	}
+}
@@ -0,0 +1,185 @@
+package utils
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestS3SignKnownVector(t *testing.T) {
+	storage, err := NewS3Storage("http://127.0.0.1:9000", "", "covers", "AKID", "SECRET", true, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	target, err := storage.objectURL("books/1/cover a.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := http.NewRequest(http.MethodPut, target.String(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	storage.sign(req, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+
+	// Beklenen imza, AWS SigV4 belgesindeki adımlarla bağımsız olarak hesaplanmıştır
+	want := "AWS4-HMAC-SHA256 Credential=AKID/20240102/us-east-1/s3/aws4_request, " +
+		"SignedHeaders=host;x-amz-content-sha256;x-amz-date, " +
+		"Signature=0d1f0aa01589605d66ab76d8ecab2a6d974885c00609558b0a377924e595ab56"
+	if got := req.Header.Get("Authorization"); got != want {
+		t.Errorf("Authorization =\n%s\nwant\n%s", got, want)
+	}
+	if got := req.Header.Get("X-Amz-Date"); got != "20240102T030405Z" {
+		t.Errorf("X-Amz-Date = %q", got)
+	}
+}
+
+// s3Stub imzaları doğrulayan ve nesneleri bellekte tutan MinIO benzeri sunucu
+type s3Stub struct {
+	secret  string
+	mu      sync.Mutex
+	objects map[string]string
+	types   map[string]string
+}
+
+func (s *s3Stub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.validSignature(r) {
+		http.Error(w, "<Error><Code>SignatureDoesNotMatch</Code></Error>", http.StatusForbidden)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		s.objects[r.URL.EscapedPath()] = string(body)
+		s.types[r.URL.EscapedPath()] = r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		delete(s.objects, r.URL.EscapedPath())
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// validSignature isteğin Authorization başlığını sunucu tarafında yeniden hesaplar
+func (s *s3Stub) validSignature(r *http.Request) bool {
+	auth := r.Header.Get("Authorization")
+	var credential, signature string
+	for _, part := range strings.Split(strings.TrimPrefix(auth, "AWS4-HMAC-SHA256 "), ", ") {
+		name, value, _ := strings.Cut(part, "=")
+		switch name {
+		case "Credential":
+			credential = value
+		case "Signature":
+			signature = value
+		}
+	}
+	fields := strings.Split(credential, "/")
+	if len(fields) != 5 {
+		return false
+	}
+	day, region := fields[1], fields[2]
+
+	amzDate := r.Header.Get("X-Amz-Date")
+	canonical := strings.Join([]string{
+		r.Method,
+		r.URL.EscapedPath(),
+		r.URL.RawQuery,
+		"host:" + r.Host + "\nx-amz-content-sha256:" + r.Header.Get("X-Amz-Content-Sha256") + "\nx-amz-date:" + amzDate + "\n",
+		"host;x-amz-content-sha256;x-amz-date",
+		r.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+	hash := sha256.Sum256([]byte(canonical))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + strings.Join(fields[1:], "/") + "\n" + hex.EncodeToString(hash[:])
+
+	key := []byte("AWS4" + s.secret)
+	for _, part := range []string{day, region, "s3", "aws4_request"} {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(part))
+		key = mac.Sum(nil)
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(stringToSign))
+	return hmac.Equal([]byte(signature), []byte(hex.EncodeToString(mac.Sum(nil))))
+}
+
+func TestS3StoragePutDelete(t *testing.T) {
+	stub := &s3Stub{secret: "SECRET", objects: map[string]string{}, types: map[string]string{}}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	storage, err := NewS3Storage(server.URL, "eu-central-1", "covers", "AKID", "SECRET", true, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	key := "books/1/kapak ğ.jpg"
+	path := "/covers/books/1/kapak%20%C4%9F.jpg"
+
+	if err := storage.Put(ctx, key, strings.NewReader("image"), 5, "image/jpeg"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if got := stub.objects[path]; got != "image" {
+		t.Errorf("stored object = %q, want %q (objects: %v)", got, "image", stub.objects)
+	}
+	if got := stub.types[path]; got != "image/jpeg" {
+		t.Errorf("Content-Type = %q", got)
+	}
+	if got, want := storage.URL(key), server.URL+path; got != want {
+		t.Errorf("URL = %q, want %q", got, want)
+	}
+
+	if err := storage.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, ok := stub.objects[path]; ok {
+		t.Error("object not deleted")
+	}
+}
+
+func TestS3StorageRejectedSignature(t *testing.T) {
+	stub := &s3Stub{secret: "OTHER", objects: map[string]string{}, types: map[string]string{}}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	storage, err := NewS3Storage(server.URL, "", "covers", "AKID", "SECRET", true, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = storage.Put(context.Background(), "a.jpg", strings.NewReader("x"), 1, "image/jpeg")
+	if err == nil || !strings.Contains(err.Error(), "403") {
+		t.Errorf("Put error = %v, want 403", err)
+	}
+}
+
+func TestS3StorageURL(t *testing.T) {
+	tests := []struct {
+		pathStyle bool
+		publicURL string
+		want      string
+	}{
+		{true, "", "https://s3.example.com/covers/books/1.jpg"},
+		{false, "", "https://covers.s3.example.com/books/1.jpg"},
+		{false, "https://cdn.example.com/", "https://cdn.example.com/books/1.jpg"},
+	}
+	for _, tt := range tests {
+		storage, err := NewS3Storage("s3.example.com", "", "covers", "AKID", "SECRET", tt.pathStyle, tt.publicURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := storage.URL("books/1.jpg"); got != tt.want {
+			t.Errorf("URL(pathStyle=%v, publicURL=%q) = %q, want %q", tt.pathStyle, tt.publicURL, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// ErrWebhookAddress webhook adresi https değilse veya iç ağa çözülüyorsa döner
+var ErrWebhookAddress = errors.New("webhook adresi https olmalı ve herkese açık bir sunucuya çözülmelidir")
+
+// webhookDialer bağlanılan her adresi yeniden denetler; kayıt sırasında
+// doğrulanan bir alan adı sonradan iç ağdaki bir adrese çözülse de bağlantı
+// kurulmaz
+var webhookDialer = &net.Dialer{
+	Timeout: 5 * time.Second,
+	Control: func(network, address string, _ syscall.RawConn) error {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return err
+		}
+		if ip := net.ParseIP(host); ip == nil || blockedWebhookIP(ip) {
+			return ErrWebhookAddress
+		}
+		return nil
+	},
+}
+
+// webhookClient webhook isteklerinde kullanılan HTTP istemcisi. Proxy
+// kullanılmaz ve yönlendirmeler izlenmez; 3xx yanıtları başarısız sayılır.
+var webhookClient = &http.Client{
+	Timeout: 10 * time.Second,
+	Transport: &http.Transport{
+		DialContext:         webhookDialer.DialContext,
+		TLSHandshakeTimeout: 5 * time.Second,
+	},
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// blockedWebhookIP loopback, özel, link-local, multicast ve belirtilmemiş
+// adresleri reddeder
+func blockedWebhookIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast()
+}
+
+// ValidateWebhookURL adresin https olduğunu ve sunucunun çözülen tüm
+// adreslerinin herkese açık olduğunu denetler
+func ValidateWebhookURL(ctx context.Context, rawURL string) error {
+	target, err := url.Parse(rawURL)
+	if err != nil || target.Scheme != "https" || target.Hostname() == "" || target.User != nil {
+		return ErrWebhookAddress
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, target.Hostname())
+	if err != nil {
+		return fmt.Errorf("webhook sunucusu çözülemedi: %w", err)
+	}
+	for _, addr := range addrs {
+		if blockedWebhookIP(addr.IP) {
+			return ErrWebhookAddress
+		}
+	}
+	return nil
+}
+
+// PostWebhook payload'ı JSON olarak adrese gönderir; 2xx dışındaki yanıtlar hatadır
+func PostWebhook(ctx context.Context, rawURL string, payload interface{}) error {
+	target, err := url.Parse(rawURL)
+	if err != nil || target.Scheme != "https" {
+		return ErrWebhookAddress
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook yanıtı başarısız: %s", resp.Status)
+	}
+	return nil
+}